@@ -15,6 +15,23 @@ type ConfigList struct {
 	DbHost         string
 	DbPort         string
 	ServerPort     int
+
+	TrashRetentionDays int
+
+	StorageDriver       string
+	StorageLocalDir     string
+	S3Endpoint          string
+	S3Region            string
+	S3Bucket            string
+	S3AccessKeyID       string
+	S3SecretAccessKey   string
+	AttachmentMaxSizeMB int
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
 }
 
 var Config ConfigList
@@ -29,6 +46,22 @@ func init() {
 	godotenv.Load(envFilePath)
 
 	serverPort, _ := strconv.Atoi(os.Getenv("SERVER_PORT"))
+	trashRetentionDays, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS"))
+	if err != nil {
+		trashRetentionDays = 30
+	}
+	storageLocalDir := os.Getenv("STORAGE_LOCAL_DIR")
+	if storageLocalDir == "" {
+		storageLocalDir = "/app/uploads"
+	}
+	attachmentMaxSizeMB, err := strconv.Atoi(os.Getenv("ATTACHMENT_MAX_SIZE_MB"))
+	if err != nil {
+		attachmentMaxSizeMB = 10
+	}
+	smtpPort, err := strconv.Atoi(os.Getenv("SMTP_PORT"))
+	if err != nil {
+		smtpPort = 587
+	}
 	Config = ConfigList{
 		DbDriverName:   os.Getenv("DB_DRIVER_NAME"),
 		DbName:         os.Getenv("DB_NAME"),
@@ -37,5 +70,22 @@ func init() {
 		DbHost:         os.Getenv("DB_HOST"),
 		DbPort:         os.Getenv("DB_PORT"),
 		ServerPort:     serverPort,
+
+		TrashRetentionDays: trashRetentionDays,
+
+		StorageDriver:       os.Getenv("STORAGE_DRIVER"),
+		StorageLocalDir:     storageLocalDir,
+		S3Endpoint:          os.Getenv("S3_ENDPOINT"),
+		S3Region:            os.Getenv("S3_REGION"),
+		S3Bucket:            os.Getenv("S3_BUCKET"),
+		S3AccessKeyID:       os.Getenv("S3_ACCESS_KEY_ID"),
+		S3SecretAccessKey:   os.Getenv("S3_SECRET_ACCESS_KEY"),
+		AttachmentMaxSizeMB: attachmentMaxSizeMB,
+
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     smtpPort,
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		SMTPFrom:     os.Getenv("SMTP_FROM"),
 	}
 }
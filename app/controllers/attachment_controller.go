@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NOTE: multipartの境界やヘッダー分としてファイルサイズ上限に上乗せする
+const multipartOverhead = 1 << 20
+
+type AttachmentController interface {
+	Upload(ctx *gin.Context)
+	Index(ctx *gin.Context)
+	Download(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type attachmentController struct {
+	attachmentService services.AttachmentService
+	authService       services.AuthService
+}
+
+func NewAttachmentController(attachmentService services.AttachmentService, authService services.AuthService) AttachmentController {
+	return &attachmentController{attachmentService, authService}
+}
+
+func (attachmentController *attachmentController) Upload(ctx *gin.Context) {
+	user, err := attachmentController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, attachmentController.attachmentService.MaxUploadSize()+multipartOverhead)
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		if _, ok := err.(*http.MaxBytesError); ok {
+			ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "file is too large"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	defer file.Close()
+
+	requestParams := dto.UploadAttachmentRequest{FileName: fileHeader.Filename, Size: fileHeader.Size, File: file}
+	result := attachmentController.attachmentService.UploadAttachment(todoId, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"attachment": result.Attachment})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "requestEntityTooLarge":
+		ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": result.Error.Error()})
+	case "unsupportedMediaType":
+		ctx.JSON(http.StatusUnsupportedMediaType, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (attachmentController *attachmentController) Index(ctx *gin.Context) {
+	user, err := attachmentController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	result := attachmentController.attachmentService.FetchAttachmentsList(todoId, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"attachments": result.Attachments})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (attachmentController *attachmentController) Download(ctx *gin.Context) {
+	user, err := attachmentController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	id, err := strconv.Atoi(ctx.Param("attachment_id"))
+	result := attachmentController.attachmentService.DownloadAttachment(todoId, id, user.ID)
+
+	if result.Error == nil {
+		defer result.Body.Close()
+		// NOTE: ブラウザでの内容推測による表示を防ぐ
+		headers := map[string]string{
+			"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": result.Attachment.FileName}),
+			"X-Content-Type-Options": "nosniff",
+		}
+		ctx.DataFromReader(http.StatusOK, result.Attachment.Size, result.Attachment.ContentType, result.Body, headers)
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (attachmentController *attachmentController) Delete(ctx *gin.Context) {
+	user, err := attachmentController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	id, err := strconv.Atoi(ctx.Param("attachment_id"))
+	result := attachmentController.attachmentService.DeleteAttachment(todoId, id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/storage"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testAttachmentController AttachmentController
+
+type TestAttachmentControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestAttachmentControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	attachmentRepository := repositories.NewAttachmentRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	attachmentService := services.NewAttachmentService(todoRepository, todoShareRepository, attachmentRepository, storage.NewLocalStorage(s.T().TempDir()), 1024)
+
+	// NOTE: テスト対象のコントローラを設定
+	testAttachmentController = NewAttachmentController(attachmentService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestAttachmentControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+// NOTE: ファイルを1つ含むmultipartのリクエストを作成する
+func (s *TestAttachmentControllerSuite) newUploadRequest(fileName string, content []byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", fileName)
+	part.Write(content)
+	writer.Close()
+
+	todoId := strconv.Itoa(todo.ID)
+	req, _ := http.NewRequest(http.MethodPost, "/todos/"+todoId+"/attachments", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Cookie", "token="+token)
+	return req
+}
+
+func (s *TestAttachmentControllerSuite) TestUploadAndDownload() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "id", Value: strconv.Itoa(todo.ID)}}
+	c.Request = s.newUploadRequest("memo.txt", []byte("test text"))
+	testAttachmentController.Upload(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	attachmentId := strconv.Itoa(int(responseBody["attachment"]["id"].(float64)))
+
+	res = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "attachment_id", Value: attachmentId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/attachments/"+attachmentId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testAttachmentController.Download(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	assert.Equal(s.T(), "test text", res.Body.String())
+	assert.Equal(s.T(), "text/plain", res.Header().Get("Content-Type"))
+	assert.Equal(s.T(), "attachment; filename=memo.txt", res.Header().Get("Content-Disposition"))
+	assert.Equal(s.T(), "nosniff", res.Header().Get("X-Content-Type-Options"))
+}
+
+func (s *TestAttachmentControllerSuite) TestUpload_TooLarge() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "id", Value: strconv.Itoa(todo.ID)}}
+	c.Request = s.newUploadRequest("memo.txt", bytes.Repeat([]byte("a"), 2048))
+	testAttachmentController.Upload(c)
+
+	assert.Equal(s.T(), 413, res.Code)
+}
+
+func (s *TestAttachmentControllerSuite) TestUpload_UnsupportedMediaType() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "id", Value: strconv.Itoa(todo.ID)}}
+	c.Request = s.newUploadRequest("page.txt", []byte("<html><body>test</body></html>"))
+	testAttachmentController.Upload(c)
+
+	assert.Equal(s.T(), 415, res.Code)
+}
+
+func (s *TestAttachmentControllerSuite) TestIndex() {
+	attachment := models.Attachment{TodoID: todo.ID, UserID: user.ID, FileName: "test.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/1/key1"}
+	if err := DbCon.Create(&attachment).Error; err != nil {
+		s.T().Fatalf("failed to create test attachment %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/attachments", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testAttachmentController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["attachments"], 1)
+}
+
+func (s *TestAttachmentControllerSuite) TestDelete_NotFound() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "attachment_id", Value: "0"}}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/"+todoId+"/attachments/0", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testAttachmentController.Delete(c)
+
+	assert.Equal(s.T(), 404, res.Code)
+}
+
+func TestAttachmentController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestAttachmentControllerSuite))
+}
@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BoardController interface {
+	Create(ctx *gin.Context)
+	Index(ctx *gin.Context)
+	Show(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Move(ctx *gin.Context)
+}
+
+type boardController struct {
+	boardService services.BoardService
+	authService  services.AuthService
+}
+
+func NewBoardController(boardService services.BoardService, authService services.AuthService) BoardController {
+	return &boardController{boardService, authService}
+}
+
+func (boardController *boardController) Create(ctx *gin.Context) {
+	user, err := boardController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.CreateBoardRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := boardController.boardService.CreateBoard(requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"board": result.Board})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (boardController *boardController) Index(ctx *gin.Context) {
+	user, err := boardController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	result := boardController.boardService.FetchBoardsList(user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"boards": result.Boards})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (boardController *boardController) Show(ctx *gin.Context) {
+	user, err := boardController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := boardController.boardService.FetchBoard(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"board": result.Board, "columns": result.Columns})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (boardController *boardController) Update(ctx *gin.Context) {
+	user, err := boardController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.UpdateBoardRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := boardController.boardService.UpdateBoard(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"board": result.Board})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (boardController *boardController) Delete(ctx *gin.Context) {
+	user, err := boardController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := boardController.boardService.DeleteBoard(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (boardController *boardController) Move(ctx *gin.Context) {
+	user, err := boardController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.MoveBoardCardRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := boardController.boardService.MoveBoardCard(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "conflict":
+		ctx.JSON(http.StatusConflict, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testBoardController BoardController
+var board models.Board
+
+type TestBoardControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestBoardControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todo・ボードの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	board = models.Board{UserID: user.ID, Name: "test board", GroupBy: models.BoardGroupByStatus, Columns: []models.BoardColumn{
+		{Name: "Todo", Value: models.TodoStatusTodo, Position: 0},
+		{Name: "Done", Value: models.TodoStatusDone, Position: 1},
+	}}
+	if err := DbCon.Create(&board).Error; err != nil {
+		s.T().Fatalf("failed to create test board %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	boardRepository := repositories.NewBoardRepository(DbCon)
+	transactionRepository := repositories.NewTransactionRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	boardService := services.NewBoardService(boardRepository, todoRepository, transactionRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testBoardController = NewBoardController(boardService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestBoardControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestBoardControllerSuite) TestCreate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	createBoardBody := bytes.NewBufferString("{\"name\":\"test board 2\",\"group_by\":\"tag\",\"columns\":[{\"name\":\"Home\",\"value\":\"#Home\"}]}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/boards", createBoardBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testBoardController.Create(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	columns := responseBody["board"]["columns"].([]interface{})
+	assert.Equal(s.T(), "home", columns[0].(map[string]interface{})["value"])
+}
+
+func (s *TestBoardControllerSuite) TestCreate_ValidationError() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	createBoardBody := bytes.NewBufferString("{\"name\":\"\",\"group_by\":\"priority\",\"columns\":[{\"name\":\"High\",\"value\":\"high\"}]}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/boards", createBoardBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testBoardController.Create(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func (s *TestBoardControllerSuite) TestShow() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	boardId := strconv.Itoa(board.ID)
+	c.Params = gin.Params{{Key: "id", Value: boardId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/boards/"+boardId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testBoardController.Show(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	columns := responseBody["columns"].([]interface{})
+	assert.Len(s.T(), columns, 2)
+	assert.Len(s.T(), columns[0].(map[string]interface{})["todos"], 1)
+}
+
+func (s *TestBoardControllerSuite) TestMove() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	boardId := strconv.Itoa(board.ID)
+	c.Params = gin.Params{{Key: "id", Value: boardId}}
+	moveBody := bytes.NewBufferString("{\"todo_id\":" + strconv.Itoa(todo.ID) + ",\"column_id\":" + strconv.Itoa(board.Columns[1].ID) + "}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/boards/"+boardId+"/move", moveBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testBoardController.Move(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), models.TodoStatusDone, responseBody["todo"]["status"])
+}
+
+func (s *TestBoardControllerSuite) TestDelete() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	boardId := strconv.Itoa(board.ID)
+	c.Params = gin.Params{{Key: "id", Value: boardId}}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/boards/"+boardId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testBoardController.Delete(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+}
+
+func TestBoardController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestBoardControllerSuite))
+}
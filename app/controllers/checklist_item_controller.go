@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChecklistItemController interface {
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Reorder(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type checklistItemController struct {
+	checklistItemService services.ChecklistItemService
+	authService          services.AuthService
+}
+
+func NewChecklistItemController(checklistItemService services.ChecklistItemService, authService services.AuthService) ChecklistItemController {
+	return &checklistItemController{checklistItemService, authService}
+}
+
+func (checklistItemController *checklistItemController) Create(ctx *gin.Context) {
+	user, err := checklistItemController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.CreateChecklistItemRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := checklistItemController.checklistItemService.CreateChecklistItem(todoId, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"checklist_item": result.ChecklistItem})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (checklistItemController *checklistItemController) Update(ctx *gin.Context) {
+	user, err := checklistItemController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	id, err := strconv.Atoi(ctx.Param("item_id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.UpdateChecklistItemRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := checklistItemController.checklistItemService.UpdateChecklistItem(todoId, id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"checklist_item": result.ChecklistItem})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (checklistItemController *checklistItemController) Reorder(ctx *gin.Context) {
+	user, err := checklistItemController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.ReorderChecklistItemsRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := checklistItemController.checklistItemService.ReorderChecklistItems(todoId, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"checklist_items": result.ChecklistItems})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (checklistItemController *checklistItemController) Delete(ctx *gin.Context) {
+	user, err := checklistItemController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	id, err := strconv.Atoi(ctx.Param("item_id"))
+	result := checklistItemController.checklistItemService.DeleteChecklistItem(todoId, id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"result": "delete checklist item(ID: " + ctx.Param("item_id") + ") successfully"})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
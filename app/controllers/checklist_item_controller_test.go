@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	todo                        models.Todo
+	testChecklistItemController ChecklistItemController
+)
+
+type TestChecklistItemControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestChecklistItemControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	checklistItemRepository := repositories.NewChecklistItemRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	checklistItemService := services.NewChecklistItemService(todoRepository, todoShareRepository, checklistItemRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testChecklistItemController = NewChecklistItemController(checklistItemService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestChecklistItemControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestChecklistItemControllerSuite) TestCreate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	createItemBody := bytes.NewBufferString("{\"title\":\"test item 1\"}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/checklist_items", createItemBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testChecklistItemController.Create(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Contains(s.T(), responseBody["checklist_item"], "title")
+
+	// NOTE: チェックリストが作成されていることを確認
+	item := models.ChecklistItem{}
+	if err := DbCon.Where("todo_id = ?", todo.ID).First(&item).Error; err != nil {
+		s.T().Fatalf("failed to create checklist item %v", err)
+	}
+	assert.Equal(s.T(), "test item 1", item.Title)
+}
+
+func (s *TestChecklistItemControllerSuite) TestCreate_ValidationError() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	createItemBody := bytes.NewBufferString("{\"title\":\"\"}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/checklist_items", createItemBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testChecklistItemController.Create(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func (s *TestChecklistItemControllerSuite) TestUpdate() {
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	itemId := strconv.Itoa(item.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "item_id", Value: itemId}}
+	updateItemBody := bytes.NewBufferString("{\"title\":\"test item 1\",\"done\":true}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/todos/"+todoId+"/checklist_items/"+itemId, updateItemBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testChecklistItemController.Update(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: チェックが付いていることを確認
+	updatedItem := models.ChecklistItem{}
+	DbCon.First(&updatedItem, item.ID)
+	assert.True(s.T(), updatedItem.Done)
+}
+
+func (s *TestChecklistItemControllerSuite) TestReorder() {
+	items := []models.ChecklistItem{
+		{TodoID: todo.ID, Title: "test item 1", Position: 1},
+		{TodoID: todo.ID, Title: "test item 2", Position: 2},
+	}
+	if err := DbCon.Create(&items).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist items %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	reorderBody := bytes.NewBufferString("{\"item_ids\":[" + strconv.Itoa(items[1].ID) + "," + strconv.Itoa(items[0].ID) + "]}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/checklist_items/reorder", reorderBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testChecklistItemController.Reorder(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: 並び順が更新されていることを確認
+	reorderedItem := models.ChecklistItem{}
+	DbCon.First(&reorderedItem, items[1].ID)
+	assert.Equal(s.T(), 1, reorderedItem.Position)
+}
+
+func (s *TestChecklistItemControllerSuite) TestDelete() {
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	itemId := strconv.Itoa(item.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "item_id", Value: itemId}}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/"+todoId+"/checklist_items/"+itemId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testChecklistItemController.Delete(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: チェックリストが削除されていることを確認
+	deletedItem := models.ChecklistItem{}
+	err := DbCon.First(&deletedItem, item.ID).Error
+	assert.NotNil(s.T(), err)
+}
+
+func TestChecklistItemController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestChecklistItemControllerSuite))
+}
@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CommentController interface {
+	Create(ctx *gin.Context)
+	Index(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type commentController struct {
+	commentService services.CommentService
+	authService    services.AuthService
+}
+
+func NewCommentController(commentService services.CommentService, authService services.AuthService) CommentController {
+	return &commentController{commentService, authService}
+}
+
+func (commentController *commentController) Create(ctx *gin.Context) {
+	user, err := commentController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.CreateCommentRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := commentController.commentService.CreateComment(todoId, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"comment": result.Comment})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (commentController *commentController) Index(ctx *gin.Context) {
+	user, err := commentController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	result := commentController.commentService.FetchCommentsList(todoId, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"comments": result.Comments})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (commentController *commentController) Update(ctx *gin.Context) {
+	user, err := commentController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	id, err := strconv.Atoi(ctx.Param("comment_id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.UpdateCommentRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := commentController.commentService.UpdateComment(todoId, id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"comment": result.Comment})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (commentController *commentController) Delete(ctx *gin.Context) {
+	user, err := commentController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	id, err := strconv.Atoi(ctx.Param("comment_id"))
+	result := commentController.commentService.DeleteComment(todoId, id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"result": "delete comment(ID: " + ctx.Param("comment_id") + ") successfully"})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testCommentController CommentController
+
+type TestCommentControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestCommentControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	commentRepository := repositories.NewCommentRepository(DbCon)
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	commentService := services.NewCommentService(todoRepository, todoShareRepository, commentRepository, notificationRepository, userSettingRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testCommentController = NewCommentController(commentService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestCommentControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestCommentControllerSuite) TestCreate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	createCommentBody := bytes.NewBufferString("{\"body\":\"test comment 1\"}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/comments", createCommentBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testCommentController.Create(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: Todoのコメント数が増えていることを確認
+	updatedTodo := models.Todo{}
+	if err := DbCon.First(&updatedTodo, todo.ID).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), 1, updatedTodo.CommentCount)
+}
+
+func (s *TestCommentControllerSuite) TestIndex() {
+	if err := DbCon.Create(&models.Comment{TodoID: todo.ID, UserID: user.ID, Body: "test comment 1"}).Error; err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/comments", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testCommentController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["comments"], 1)
+}
+
+func (s *TestCommentControllerSuite) TestUpdate_Forbidden() {
+	author := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "author@example.com"}).(*models.User)
+	if err := DbCon.Create(&author).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	comment := models.Comment{TodoID: todo.ID, UserID: author.ID, Body: "test comment 1"}
+	if err := DbCon.Create(&comment).Error; err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	commentId := strconv.Itoa(comment.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "comment_id", Value: commentId}}
+	updateCommentBody := bytes.NewBufferString("{\"body\":\"test updated comment 1\"}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/todos/"+todoId+"/comments/"+commentId, updateCommentBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testCommentController.Update(c)
+
+	assert.Equal(s.T(), 403, res.Code)
+}
+
+func (s *TestCommentControllerSuite) TestDelete() {
+	comment := models.Comment{TodoID: todo.ID, UserID: user.ID, Body: "test comment 1"}
+	if err := DbCon.Create(&comment).Error; err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	commentId := strconv.Itoa(comment.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "comment_id", Value: commentId}}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/"+todoId+"/comments/"+commentId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testCommentController.Delete(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+}
+
+func TestCommentController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestCommentControllerSuite))
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationController interface {
+	Index(ctx *gin.Context)
+	Read(ctx *gin.Context)
+	ReadAll(ctx *gin.Context)
+}
+
+type notificationController struct {
+	notificationService services.NotificationService
+	authService         services.AuthService
+}
+
+func NewNotificationController(notificationService services.NotificationService, authService services.AuthService) NotificationController {
+	return &notificationController{notificationService, authService}
+}
+
+func (notificationController *notificationController) Index(ctx *gin.Context) {
+	user, err := notificationController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	unreadOnly, err := strconv.ParseBool(ctx.DefaultQuery("unread_only", "false"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "unread_onlyはtrueまたはfalseで指定してください"})
+		return
+	}
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "limitは整数で指定してください"})
+		return
+	}
+	offset, err := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "offsetは整数で指定してください"})
+		return
+	}
+	result := notificationController.notificationService.FetchNotificationsList(dto.NotificationsListRequest{UnreadOnly: unreadOnly, Limit: limit, Offset: offset}, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"notifications": result.Notifications, "total": result.Total, "unread_count": result.UnreadCount})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (notificationController *notificationController) Read(ctx *gin.Context) {
+	user, err := notificationController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := notificationController.notificationService.ReadNotification(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"notification": result.Notification})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (notificationController *notificationController) ReadAll(ctx *gin.Context) {
+	user, err := notificationController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	result := notificationController.notificationService.ReadAllNotifications(user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"read_count": result.ReadCount})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
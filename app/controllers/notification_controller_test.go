@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testNotificationController NotificationController
+
+type TestNotificationControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestNotificationControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・通知の作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	now := time.Now()
+	notifications := []models.Notification{
+		{UserID: user.ID, Type: models.NotificationTypeAssigned, Title: "test notification 1", CreatedAt: now.Add(-time.Minute)},
+		{UserID: user.ID, Type: models.NotificationTypeCommented, Title: "test notification 2", CreatedAt: now},
+	}
+	if err := DbCon.Create(&notifications).Error; err != nil {
+		s.T().Fatalf("failed to create test notifications %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	notificationService := services.NewNotificationService(notificationRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testNotificationController = NewNotificationController(notificationService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestNotificationControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestNotificationControllerSuite) TestIndex() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/notifications?limit=1", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testNotificationController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := struct {
+		Notifications []map[string]interface{} `json:"notifications"`
+		Total         int64                    `json:"total"`
+		UnreadCount   int64                    `json:"unread_count"`
+	}{}
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody.Notifications, 1)
+	assert.Equal(s.T(), "test notification 2", responseBody.Notifications[0]["title"])
+	assert.Equal(s.T(), int64(2), responseBody.Total)
+	assert.Equal(s.T(), int64(2), responseBody.UnreadCount)
+}
+
+func (s *TestNotificationControllerSuite) TestIndex_BadRequest() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/notifications?unread_only=yes", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testNotificationController.Index(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func (s *TestNotificationControllerSuite) TestRead() {
+	notification := models.Notification{}
+	DbCon.Where("title = ?", "test notification 1").First(&notification)
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	notificationId := strconv.Itoa(notification.ID)
+	c.Params = gin.Params{{Key: "id", Value: notificationId}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/notifications/"+notificationId+"/read", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testNotificationController.Read(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.NotNil(s.T(), responseBody["notification"]["read_at"])
+}
+
+func (s *TestNotificationControllerSuite) TestRead_NotFound() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "id", Value: "0"}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/notifications/0/read", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testNotificationController.Read(c)
+
+	assert.Equal(s.T(), 404, res.Code)
+}
+
+func (s *TestNotificationControllerSuite) TestReadAll() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/notifications/read", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testNotificationController.ReadAll(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]int64)
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), int64(2), responseBody["read_count"])
+}
+
+func TestNotificationController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestNotificationControllerSuite))
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReminderController interface {
+	Create(ctx *gin.Context)
+	Index(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type reminderController struct {
+	reminderService services.ReminderService
+	authService     services.AuthService
+}
+
+func NewReminderController(reminderService services.ReminderService, authService services.AuthService) ReminderController {
+	return &reminderController{reminderService, authService}
+}
+
+func (reminderController *reminderController) Create(ctx *gin.Context) {
+	user, err := reminderController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.CreateReminderRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := reminderController.reminderService.CreateReminder(todoId, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"reminder": result.Reminder})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (reminderController *reminderController) Index(ctx *gin.Context) {
+	user, err := reminderController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	result := reminderController.reminderService.FetchRemindersList(todoId, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"reminders": result.Reminders})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (reminderController *reminderController) Delete(ctx *gin.Context) {
+	user, err := reminderController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	id, err := strconv.Atoi(ctx.Param("reminder_id"))
+	result := reminderController.reminderService.DeleteReminder(todoId, id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
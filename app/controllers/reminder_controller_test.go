@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"app/models"
+	"app/notifier"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testReminderController ReminderController
+
+type TestReminderControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestReminderControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	dueAt := time.Now().Add(24 * time.Hour)
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID, DueAt: &dueAt}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	reminderRepository := repositories.NewReminderRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	reminderService := services.NewReminderService(todoRepository, todoShareRepository, reminderRepository, userSettingRepository, notifier.Init(notificationRepository))
+
+	// NOTE: テスト対象のコントローラを設定
+	testReminderController = NewReminderController(reminderService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestReminderControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestReminderControllerSuite) TestCreate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	createReminderBody := bytes.NewBufferString("{\"offset_minutes\":60,\"channels\":[\"email\",\"in_app\"]}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/reminders", createReminderBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testReminderController.Create(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), float64(60), responseBody["reminder"]["offset_minutes"])
+	assert.NotNil(s.T(), responseBody["reminder"]["fire_at"])
+}
+
+func (s *TestReminderControllerSuite) TestCreate_ValidationError() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	createReminderBody := bytes.NewBufferString("{\"offset_minutes\":60,\"channels\":[\"sms\"]}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/reminders", createReminderBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testReminderController.Create(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func (s *TestReminderControllerSuite) TestIndex() {
+	offsetMinutes := 30
+	reminder := models.Reminder{TodoID: todo.ID, UserID: user.ID, OffsetMinutes: &offsetMinutes, Channels: []string{models.ReminderChannelInApp}, DeliveredChannels: []string{}}
+	if err := DbCon.Create(&reminder).Error; err != nil {
+		s.T().Fatalf("failed to create test reminder %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/reminders", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testReminderController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string][]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["reminders"], 1)
+}
+
+func (s *TestReminderControllerSuite) TestDelete() {
+	offsetMinutes := 30
+	reminder := models.Reminder{TodoID: todo.ID, UserID: user.ID, OffsetMinutes: &offsetMinutes, Channels: []string{models.ReminderChannelInApp}, DeliveredChannels: []string{}}
+	if err := DbCon.Create(&reminder).Error; err != nil {
+		s.T().Fatalf("failed to create test reminder %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	reminderId := strconv.Itoa(reminder.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "reminder_id", Value: reminderId}}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/"+todoId+"/reminders/"+reminderId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testReminderController.Delete(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	var count int64
+	DbCon.Model(&models.Reminder{}).Where("id = ?", reminder.ID).Count(&count)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func TestReminderController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestReminderControllerSuite))
+}
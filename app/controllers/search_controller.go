@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchController interface {
+	Index(ctx *gin.Context)
+}
+
+type searchController struct {
+	searchService services.SearchService
+	authService   services.AuthService
+}
+
+func NewSearchController(searchService services.SearchService, authService services.AuthService) SearchController {
+	return &searchController{searchService, authService}
+}
+
+func (searchController *searchController) Index(ctx *gin.Context) {
+	user, err := searchController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "20"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "limitは整数で指定してください"})
+		return
+	}
+	offset, err := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "offsetは整数で指定してください"})
+		return
+	}
+	result := searchController.searchService.Search(dto.SearchRequest{Query: ctx.Query("q"), Limit: limit, Offset: offset}, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"hits": result.Hits})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/search"
+	"app/services"
+	"app/test/factories"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testSearchController SearchController
+
+type TestSearchControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestSearchControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	// NOTE: FULLTEXTインデックスの代わりにメモリ上の実装で検索する
+	searcher := search.NewMemorySearcher()
+	searcher.Index(
+		search.Document{Type: search.HitTypeTodo, TodoID: 1, Title: "会議の準備", Body: "資料を印刷する", ReaderIDs: []int{user.ID}},
+		search.Document{Type: search.HitTypeTodo, TodoID: 2, Title: "他人の会議", Body: "", ReaderIDs: []int{user.ID + 1}},
+	)
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	authService := services.NewAuthService(userRepository)
+	searchService := services.NewSearchService(searcher)
+
+	// NOTE: テスト対象のコントローラを設定
+	testSearchController = NewSearchController(searchService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestSearchControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestSearchControllerSuite) TestIndex() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/search?q=会議", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testSearchController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string][]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["hits"], 1)
+	assert.Equal(s.T(), "<mark>会議</mark>の準備", responseBody["hits"][0]["title"])
+}
+
+func (s *TestSearchControllerSuite) TestIndex_BadRequest() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/search", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testSearchController.Index(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+
+	res = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/search?q=会議&limit=all", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testSearchController.Index(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func TestSearchController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestSearchControllerSuite))
+}
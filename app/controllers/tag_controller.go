@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagController interface {
+	Index(ctx *gin.Context)
+	Update(ctx *gin.Context)
+}
+
+type tagController struct {
+	tagService  services.TagService
+	authService services.AuthService
+}
+
+func NewTagController(tagService services.TagService, authService services.AuthService) TagController {
+	return &tagController{tagService, authService}
+}
+
+func (tagController *tagController) Index(ctx *gin.Context) {
+	user, err := tagController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	result := tagController.tagService.FetchTagsList(user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"tags": result.Tags})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (tagController *tagController) Update(ctx *gin.Context) {
+	user, err := tagController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.SetTodoTagsRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := tagController.tagService.SetTodoTags(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testTagController TagController
+
+type TestTagControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestTagControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	tagRepository := repositories.NewTagRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	tagService := services.NewTagService(todoRepository, todoShareRepository, tagRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testTagController = NewTagController(tagService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestTagControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTagControllerSuite) TestUpdate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	updateTagsBody := bytes.NewBufferString("{\"tags\":[\"#Home\",\"work\"]}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/todos/"+todoId+"/tags", updateTagsBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTagController.Update(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), []interface{}{"home", "work"}, responseBody["todo"]["tags"])
+}
+
+func (s *TestTagControllerSuite) TestIndex() {
+	if err := DbCon.Create(&models.TodoTag{TodoID: todo.ID, Name: "home"}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo tag %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/tags", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTagController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), []interface{}{"home"}, responseBody["tags"])
+}
+
+func TestTagController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTagControllerSuite))
+}
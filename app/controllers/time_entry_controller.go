@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NOTE: 集計期間の上限日数
+const maxTimeReportDays = 366
+
+type TimeEntryController interface {
+	Start(ctx *gin.Context)
+	Stop(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Index(ctx *gin.Context)
+	Report(ctx *gin.Context)
+}
+
+type timeEntryController struct {
+	timeEntryService services.TimeEntryService
+	authService      services.AuthService
+}
+
+func NewTimeEntryController(timeEntryService services.TimeEntryService, authService services.AuthService) TimeEntryController {
+	return &timeEntryController{timeEntryService, authService}
+}
+
+func (timeEntryController *timeEntryController) Start(ctx *gin.Context) {
+	user, err := timeEntryController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストボディは省略可能
+	requestParams := dto.StartTimerRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := timeEntryController.timeEntryService.StartTimer(todoId, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"time_entry": result.TimeEntry})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "conflict":
+		ctx.JSON(http.StatusConflict, gin.H{"error": result.Error.Error(), "time_entry": result.TimeEntry})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (timeEntryController *timeEntryController) Stop(ctx *gin.Context) {
+	user, err := timeEntryController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	result := timeEntryController.timeEntryService.StopTimer(todoId, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"time_entry": result.TimeEntry})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error.Error()})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (timeEntryController *timeEntryController) Create(ctx *gin.Context) {
+	user, err := timeEntryController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.CreateTimeEntryRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := timeEntryController.timeEntryService.CreateTimeEntry(todoId, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"time_entry": result.TimeEntry})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (timeEntryController *timeEntryController) Index(ctx *gin.Context) {
+	user, err := timeEntryController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	todoId, err := strconv.Atoi(ctx.Param("id"))
+	result := timeEntryController.timeEntryService.FetchTimeEntriesList(todoId, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"time_entries": result.TimeEntries})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (timeEntryController *timeEntryController) Report(ctx *gin.Context) {
+	user, err := timeEntryController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	// NOTE: from・toは両端を含む日付で指定する
+	from, fromErr := time.ParseInLocation(time.DateOnly, ctx.Query("from"), time.Local)
+	to, toErr := time.ParseInLocation(time.DateOnly, ctx.Query("to"), time.Local)
+	if fromErr != nil || toErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "from・toはYYYY-MM-DD形式で指定してください"})
+		return
+	}
+	if to.Before(from) || to.After(from.AddDate(0, 0, maxTimeReportDays-1)) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "期間はfrom以降のtoで" + strconv.Itoa(maxTimeReportDays) + "日以内を指定してください"})
+		return
+	}
+	requestParams := dto.TimeReportRequest{From: from, Until: to.AddDate(0, 0, 1)}
+	result := timeEntryController.timeEntryService.FetchTimeReport(requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"report": result.Report})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testTimeEntryController TimeEntryController
+
+type TestTimeEntryControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestTimeEntryControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	timeEntryRepository := repositories.NewTimeEntryRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	timeEntryService := services.NewTimeEntryService(todoRepository, todoShareRepository, timeEntryRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testTimeEntryController = NewTimeEntryController(timeEntryService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestTimeEntryControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTimeEntryControllerSuite) TestStart() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/time_entries/start", http.NoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTimeEntryController.Start(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+}
+
+func (s *TestTimeEntryControllerSuite) TestStart_AlreadyRunning() {
+	if err := DbCon.Create(&models.TimeEntry{TodoID: todo.ID, UserID: user.ID, StartedAt: time.Now()}).Error; err != nil {
+		s.T().Fatalf("failed to create test time entry %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/time_entries/start", http.NoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTimeEntryController.Start(c)
+
+	assert.Equal(s.T(), 409, res.Code)
+}
+
+func (s *TestTimeEntryControllerSuite) TestIndex() {
+	if err := DbCon.Create(&models.TimeEntry{TodoID: todo.ID, UserID: user.ID, StartedAt: time.Now()}).Error; err != nil {
+		s.T().Fatalf("failed to create test time entry %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/time_entries", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTimeEntryController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["time_entries"], 1)
+}
+
+func (s *TestTimeEntryControllerSuite) TestReport() {
+	startedAt := time.Date(2026, 1, 10, 9, 0, 0, 0, time.Local)
+	endedAt := startedAt.Add(time.Hour)
+	if err := DbCon.Create(&models.TimeEntry{TodoID: todo.ID, UserID: user.ID, StartedAt: startedAt, EndedAt: &endedAt}).Error; err != nil {
+		s.T().Fatalf("failed to create test time entry %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/time_entries/report?from=2026-01-01&to=2026-01-31", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTimeEntryController.Report(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), float64(3600), responseBody["report"]["total_seconds"])
+}
+
+func (s *TestTimeEntryControllerSuite) TestReport_InvalidRange() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/time_entries/report?from=2026-02-01&to=2026-01-31", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTimeEntryController.Report(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func TestTimeEntryController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTimeEntryControllerSuite))
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TodoBulkController interface {
+	Bulk(ctx *gin.Context)
+}
+
+type todoBulkController struct {
+	todoBulkService services.TodoBulkService
+	authService     services.AuthService
+}
+
+func NewTodoBulkController(todoBulkService services.TodoBulkService, authService services.AuthService) TodoBulkController {
+	return &todoBulkController{todoBulkService, authService}
+}
+
+func (todoBulkController *todoBulkController) Bulk(ctx *gin.Context) {
+	user, err := todoBulkController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.BulkTodoRequest{}
+	if err := ctx.ShouldBindJSON(&requestParams); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result := todoBulkController.todoBulkService.BulkTodos(requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"results": result.Results})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error(), "results": result.Results})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error.Error(), "results": result.Results})
+	}
+}
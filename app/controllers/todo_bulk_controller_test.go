@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testTodoBulkController TodoBulkController
+
+type TestTodoBulkControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoBulkControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	transactionRepository := repositories.NewTransactionRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	todoBulkService := services.NewTodoBulkService(transactionRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testTodoBulkController = NewTodoBulkController(todoBulkService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestTodoBulkControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoBulkControllerSuite) TestBulk() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	bulkBody := bytes.NewBufferString("{\"operations\":[{\"op\":\"create\",\"title\":\"test title 2\"},{\"op\":\"delete\",\"id\":" + strconv.Itoa(todo.ID) + "}]}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/bulk", bulkBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoBulkController.Bulk(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["results"], 2)
+
+	// NOTE: 作成と削除が反映されていることを確認
+	todos := []models.Todo{}
+	DbCon.Where("user_id = ?", user.ID).Find(&todos)
+	assert.Len(s.T(), todos, 1)
+	assert.Equal(s.T(), "test title 2", todos[0].Title)
+}
+
+func (s *TestTodoBulkControllerSuite) TestBulk_Rollback() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	bulkBody := bytes.NewBufferString("{\"mode\":\"atomic\",\"operations\":[{\"op\":\"delete\",\"id\":" + strconv.Itoa(todo.ID) + "},{\"op\":\"create\",\"title\":\"\"}]}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/bulk", bulkBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoBulkController.Bulk(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	results := responseBody["results"].([]interface{})
+	assert.Equal(s.T(), "rolled_back", results[0].(map[string]interface{})["status"])
+	assert.Equal(s.T(), map[string]interface{}{"Title": []interface{}{"Titleは必須です"}}, results[1].(map[string]interface{})["error"])
+
+	// NOTE: Todoが削除されていないことを確認
+	todos := []models.Todo{}
+	DbCon.Where("user_id = ?", user.ID).Find(&todos)
+	assert.Len(s.T(), todos, 1)
+}
+
+func TestTodoBulkController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoBulkControllerSuite))
+}
@@ -2,20 +2,51 @@ package controllers
 
 import (
 	"app/dto"
+	"app/models"
 	"app/services"
 	"app/utils"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	contentFormatRaw   = "raw"
+	contentFormatHTML  = "html"
+	contentFormatPlain = "plain"
+)
+
 type TodoController interface {
 	Create(ctx *gin.Context)
 	Index(ctx *gin.Context)
+	QuickAdd(ctx *gin.Context)
 	Show(ctx *gin.Context)
+	Assigned(ctx *gin.Context)
+	Assign(ctx *gin.Context)
+	Shared(ctx *gin.Context)
+	Share(ctx *gin.Context)
+	Shares(ctx *gin.Context)
+	Unshare(ctx *gin.Context)
 	Update(ctx *gin.Context)
+	Patch(ctx *gin.Context)
+	Move(ctx *gin.Context)
 	Delete(ctx *gin.Context)
+	Complete(ctx *gin.Context)
+	Reopen(ctx *gin.Context)
+	Archive(ctx *gin.Context)
+	Unarchive(ctx *gin.Context)
+	Occurrences(ctx *gin.Context)
+	Trash(ctx *gin.Context)
+	Restore(ctx *gin.Context)
+	Purge(ctx *gin.Context)
+	EmptyTrash(ctx *gin.Context)
+	History(ctx *gin.Context)
+	Revert(ctx *gin.Context)
 }
 
 type todoController struct {
@@ -44,6 +75,7 @@ func (todoController *todoController) Create(ctx *gin.Context) {
 	result := todoController.todoService.CreateTodo(requestParams, user.ID)
 
 	if result.Error == nil {
+		ctx.Header("ETag", utils.FormatETag(result.Todo.Version))
 		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
 		return
 	}
@@ -53,6 +85,40 @@ func (todoController *todoController) Create(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
 	case "validationError":
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	}
+}
+
+func (todoController *todoController) QuickAdd(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.QuickAddTodoRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+
+	result := todoController.todoService.QuickAddTodo(requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.Header("ETag", utils.FormatETag(result.Todo.Version))
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo, "parsed": result.Parsed})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
 	}
 }
 
@@ -63,9 +129,23 @@ func (todoController *todoController) Index(ctx *gin.Context) {
 		return
 	}
 
-	result := todoController.todoService.FetchTodosList(user.ID)
+	format, err := contentFormatQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter, err := todoFilterFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result := todoController.todoService.FetchTodosList(filter, user.ID)
 
 	if result.Error == nil {
+		if respondNotModified(ctx, result.ETag, result.LastModifiedAt) {
+			return
+		}
+		formatTodosContent(result.Todos, format)
 		ctx.JSON(http.StatusOK, gin.H{"todos": result.Todos})
 		return
 	}
@@ -75,6 +155,10 @@ func (todoController *todoController) Index(ctx *gin.Context) {
 		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
 	case "internalServerError":
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
 	}
 }
 
@@ -85,15 +169,193 @@ func (todoController *todoController) Show(ctx *gin.Context) {
 		return
 	}
 
+	format, err := contentFormatQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 	id, err := strconv.Atoi(ctx.Param("id"))
 	result := todoController.todoService.FetchTodo(id, user.ID)
 
+	if result.Error == nil {
+		if respondNotModified(ctx, utils.FormatETag(result.Todo.Version), result.Todo.UpdatedAt) {
+			return
+		}
+		formatTodoContent(&result.Todo, format)
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Assigned(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	format, err := contentFormatQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result := todoController.todoService.FetchAssignedTodosList(user.ID)
+
+	if result.Error == nil {
+		formatTodosContent(result.Todos, format)
+		ctx.JSON(http.StatusOK, gin.H{"todos": result.Todos})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Assign(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.AssignTodoRequest{}
+	if err := ctx.ShouldBindJSON(&requestParams); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result := todoController.todoService.AssignTodo(id, requestParams, user.ID)
+
 	if result.Error == nil {
 		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
 		return
 	}
 
 	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Shared(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	format, err := contentFormatQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result := todoController.todoService.FetchSharedTodosList(user.ID)
+
+	if result.Error == nil {
+		formatTodosContent(result.Todos, format)
+		ctx.JSON(http.StatusOK, gin.H{"todos": result.Todos})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Share(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.ShareTodoRequest{}
+	if err := ctx.ShouldBindJSON(&requestParams); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result := todoController.todoService.ShareTodo(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"share": result.Share})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Shares(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoController.todoService.FetchTodoShares(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"shares": result.Shares})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Unshare(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	shareUserId, err := strconv.Atoi(ctx.Param("user_id"))
+	result := todoController.todoService.RevokeTodoShare(id, shareUserId, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"result": "revoke share of todo(ID: " + ctx.Param("id") + ") successfully"})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
 	case "notFound":
 		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
 	case "internalServerError":
@@ -115,9 +377,11 @@ func (todoController *todoController) Update(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
 		return
 	}
+	requestParams.IfMatch = ctx.GetHeader("If-Match")
 	result := todoController.todoService.UpdateTodo(id, requestParams, user.ID)
 
 	if result.Error == nil {
+		ctx.Header("ETag", utils.FormatETag(result.Todo.Version))
 		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
 		return
 	}
@@ -125,6 +389,94 @@ func (todoController *todoController) Update(ctx *gin.Context) {
 	switch result.ErrorType {
 	case "validationError":
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "preconditionFailed":
+		ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": result.Error.Error()})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Patch(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: Content-TypeからPatchの形式を判定する(application/jsonはMerge Patchとして扱う)
+	requestParams := dto.PatchTodoRequest{}
+	switch ctx.ContentType() {
+	case "application/merge-patch+json", "application/json":
+		requestParams.PatchType = dto.PatchTypeMergePatch
+	case "application/json-patch+json":
+		requestParams.PatchType = dto.PatchTypeJSONPatch
+	default:
+		ctx.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "unsupported media type"})
+		return
+	}
+	requestParams.Patch, err = io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	requestParams.IfMatch = ctx.GetHeader("If-Match")
+	result := todoController.todoService.PatchTodo(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.Header("ETag", utils.FormatETag(result.Todo.Version))
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "preconditionFailed":
+		ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": result.Error.Error()})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Move(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.MoveTodoRequest{}
+	if err := ctx.ShouldBindJSON(&requestParams); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result := todoController.todoService.MoveTodo(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
 	case "notFound":
 		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
 	case "internalServerError":
@@ -139,7 +491,8 @@ func (todoController *todoController) Delete(ctx *gin.Context) {
 		return
 	}
 	id, err := strconv.Atoi(ctx.Param("id"))
-	result := todoController.todoService.DeleteTodo(id, user.ID)
+	requestParams := dto.DeleteTodoRequest{IfMatch: ctx.GetHeader("If-Match")}
+	result := todoController.todoService.DeleteTodo(id, requestParams, user.ID)
 
 	if result.Error == nil {
 		ctx.JSON(http.StatusOK, gin.H{"result": "delete todo(ID: " + ctx.Param("id") + ") successfully"})
@@ -147,9 +500,390 @@ func (todoController *todoController) Delete(ctx *gin.Context) {
 	}
 
 	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "preconditionFailed":
+		ctx.JSON(http.StatusPreconditionFailed, gin.H{"error": result.Error.Error()})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Complete(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストボディは省略可能
+	requestParams := dto.CompleteTodoRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := todoController.todoService.CompleteTodo(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo, "next_todo": result.NextTodo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "conflict":
+		ctx.JSON(http.StatusConflict, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Reopen(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoController.todoService.ReopenTodo(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Archive(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoController.todoService.ArchiveTodo(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Unarchive(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoController.todoService.UnarchiveTodo(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Occurrences(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: 取得件数は1〜100件(デフォルト5件)
+	count, err := strconv.Atoi(ctx.DefaultQuery("count", "5"))
+	if err != nil || count < 1 || count > 100 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "countは1〜100の整数で指定してください"})
+		return
+	}
+	result := todoController.todoService.FetchTodoOccurrences(id, count, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"occurrences": result.Occurrences})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
 	case "notFound":
 		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
 	case "internalServerError":
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
 	}
 }
+
+func (todoController *todoController) Trash(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	result := todoController.todoService.FetchTrashedTodosList(user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todos": result.Todos})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Restore(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoController.todoService.RestoreTodo(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Purge(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoController.todoService.PurgeTodo(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"result": "purge todo(ID: " + ctx.Param("id") + ") successfully"})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) EmptyTrash(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	result := todoController.todoService.PurgeTrash(user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"purged_count": result.PurgedCount})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) History(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoController.todoService.FetchTodoHistory(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"revisions": result.Revisions})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoController *todoController) Revert(ctx *gin.Context) {
+	user, err := todoController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	revisionNumber, err := strconv.Atoi(ctx.Param("revision"))
+	result := todoController.todoService.RevertTodo(id, revisionNumber, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "conflict":
+		ctx.JSON(http.StatusConflict, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+// NOTE: 内容(Markdown)の返し方をformatクエリから取得する(省略時はraw)
+func contentFormatQuery(ctx *gin.Context) (string, error) {
+	format := ctx.DefaultQuery("format", contentFormatRaw)
+	if format != contentFormatRaw && format != contentFormatHTML && format != contentFormatPlain {
+		return "", errors.New("formatはraw・html・plainのいずれかを指定してください")
+	}
+	return format, nil
+}
+
+// NOTE: htmlはサニタイズしたHTMLをcontent_htmlに、plainは記法を除いたテキストをcontent_textに設定する(contentは常にMarkdownのまま返す)
+func formatTodoContent(todo *models.Todo, format string) {
+	switch format {
+	case contentFormatHTML:
+		todo.ContentHTML = utils.RenderMarkdown(todo.Content)
+	case contentFormatPlain:
+		todo.ContentText = utils.MarkdownToPlainText(todo.Content)
+	}
+}
+
+func formatTodosContent(todos []models.Todo, format string) {
+	for i := range todos {
+		formatTodoContent(&todos[i], format)
+	}
+}
+
+// NOTE: キャッシュ検証用のヘッダを設定し、クライアントのキャッシュが有効な場合は304を返す
+func respondNotModified(ctx *gin.Context, etag string, lastModifiedAt time.Time) bool {
+	// NOTE: 認証済みユーザのデータのため共有キャッシュには保存させず、都度再検証させる
+	ctx.Header("Cache-Control", "private, no-cache")
+	ctx.Header("Vary", "Cookie")
+	ctx.Header("ETag", etag)
+	if !lastModifiedAt.IsZero() {
+		ctx.Header("Last-Modified", lastModifiedAt.UTC().Format(http.TimeFormat))
+	}
+
+	if !utils.IsNotModified(ctx.GetHeader("If-None-Match"), ctx.GetHeader("If-Modified-Since"), etag, lastModifiedAt) {
+		return false
+	}
+	ctx.AbortWithStatus(http.StatusNotModified)
+	return true
+}
+
+// NOTE: 一覧の絞り込み条件をクエリから組み立てる(priority・tagはカンマ区切りで複数指定できる)
+func todoFilterFromQuery(ctx *gin.Context) (models.TodoFilter, error) {
+	filter := models.TodoFilter{
+		Status:     ctx.Query("status"),
+		Priorities: splitQueryValues(ctx.Query("priority")),
+		Tags:       splitQueryValues(ctx.Query("tag")),
+	}
+	if overdue := ctx.Query("overdue"); overdue != "" {
+		value, err := strconv.ParseBool(overdue)
+		if err != nil {
+			return filter, errors.New("overdueはtrueまたはfalseで指定してください")
+		}
+		filter.Overdue = value
+	}
+	if includeArchived := ctx.Query("include_archived"); includeArchived != "" {
+		value, err := strconv.ParseBool(includeArchived)
+		if err != nil {
+			return filter, errors.New("include_archivedはtrueまたはfalseで指定してください")
+		}
+		filter.IncludeArchived = value
+	}
+	var err error
+	if filter.DueFromDays, err = daysQuery(ctx, "due_from_days"); err != nil {
+		return filter, err
+	}
+	if filter.DueToDays, err = daysQuery(ctx, "due_to_days"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+// NOTE: 未指定の場合はnilを返す
+func daysQuery(ctx *gin.Context, key string) (*int, error) {
+	if ctx.Query(key) == "" {
+		return nil, nil
+	}
+	days, err := strconv.Atoi(ctx.Query(key))
+	if err != nil {
+		return nil, errors.New(key + "は整数で指定してください")
+	}
+	return &days, nil
+}
+
+func splitQueryValues(query string) []string {
+	values := []string{}
+	for _, value := range strings.Split(query, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -37,9 +38,14 @@ func (s *TestTodoControllerSuite) SetupTest() {
 
 	userRepository := repositories.NewUserRepository(DbCon)
 	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoRevisionRepository := repositories.NewTodoRevisionRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+	transactionRepository := repositories.NewTransactionRepository(DbCon)
 
 	authService := services.NewAuthService(userRepository)
-	todoService := services.NewTodoService(todoRepository)
+	todoService := services.NewTodoService(todoRepository, todoRevisionRepository, userRepository, todoShareRepository, notificationRepository, userSettingRepository, transactionRepository)
 
 	// NOTE: テスト対象のコントローラを設定
 	testTodoController = NewTodoController(todoService, authService)
@@ -92,6 +98,18 @@ func (s *TestTodoControllerSuite) TestCreateTodo_ValidationError() {
 	assert.NotNil(s.T(), err)
 }
 
+func (s *TestTodoControllerSuite) TestCreateTodo_InvalidRecurrenceRule() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	createTodoBody := bytes.NewBufferString("{\"title\":\"test title 1\",\"due_at\":\"2024-01-01T09:00:00+09:00\",\"recurrence_rule\":\"FREQ=HOURLY\"}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos", createTodoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Create(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
 func (s *TestTodoControllerSuite) TestIndex() {
 	// NOTE: Todoのデータを作っておく
 	todos := []models.Todo{
@@ -114,6 +132,57 @@ func (s *TestTodoControllerSuite) TestIndex() {
 	assert.Len(s.T(), responseBody["todos"], 2)
 }
 
+func (s *TestTodoControllerSuite) TestQuickAdd() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	quickAddBody := bytes.NewBufferString("{\"text\":\"明日9時に家賃を払う #home !high\"}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/quick", quickAddBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.QuickAdd(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), "家賃を払う", responseBody["todo"]["Title"])
+	assert.Equal(s.T(), "high", responseBody["parsed"]["priority"])
+	assert.Equal(s.T(), []interface{}{"home"}, responseBody["parsed"]["tags"])
+	assert.NotNil(s.T(), responseBody["parsed"]["due_at"])
+}
+
+func (s *TestTodoControllerSuite) TestIndex_Filter() {
+	// NOTE: Todoのデータを作っておく
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID, Priority: models.TodoPriorityHigh},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID, Priority: models.TodoPriorityLow},
+		{Title: "test title 3", Content: "test content 3", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos?priority=high,medium&status=todo", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 1)
+}
+
+func (s *TestTodoControllerSuite) TestIndex_InvalidFilter() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos?due_from_days=tomorrow", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Index(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
 func (s *TestTodoControllerSuite) TestShow() {
 	// NOTE: Todoのデータを作っておく
 	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
@@ -133,6 +202,60 @@ func (s *TestTodoControllerSuite) TestShow() {
 	assert.Equal(s.T(), 200, res.Code)
 }
 
+func (s *TestTodoControllerSuite) TestShow_FormatHTML() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "**bold** <script>alert(1)</script>", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"?format=html", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Show(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), "**bold** <script>alert(1)</script>", responseBody["todo"]["Content"])
+	assert.Contains(s.T(), responseBody["todo"]["content_html"], "<strong>bold</strong>")
+	assert.NotContains(s.T(), responseBody["todo"]["content_html"], "<script")
+	assert.NotContains(s.T(), responseBody["todo"], "content_text")
+}
+
+func (s *TestTodoControllerSuite) TestIndex_FormatPlain() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "# Heading\n\n*emphasis*", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos?format=plain", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string][]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), "Heading\nemphasis", responseBody["todos"][0]["content_text"])
+	assert.NotContains(s.T(), responseBody["todos"][0], "content_html")
+}
+
+func (s *TestTodoControllerSuite) TestIndex_InvalidFormat() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos?format=pdf", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Index(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
 func (s *TestTodoControllerSuite) TestUpdate() {
 	// NOTE: Todoのデータを作っておく
 	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
@@ -212,6 +335,834 @@ func (s *TestTodoControllerSuite) TestDelete() {
 	assert.NotNil(s.T(), err)
 }
 
+func (s *TestTodoControllerSuite) TestComplete() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	completeTodoBody := bytes.NewBufferString("{\"complete_checklist_items\":true}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/complete", completeTodoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Complete(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: Todoとチェックリストが完了になっていることを確認
+	completedTodo := models.Todo{}
+	if err := DbCon.Preload("ChecklistItems").Where("user_id = ?", user.ID).First(&completedTodo).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), models.TodoStatusDone, completedTodo.Status)
+	assert.True(s.T(), completedTodo.ChecklistItems[0].Done)
+}
+
+func (s *TestTodoControllerSuite) TestComplete_WithoutBody() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/complete", http.NoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Complete(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+}
+
+func (s *TestTodoControllerSuite) TestReopen() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/reopen", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Reopen(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	reopenedTodo := models.Todo{}
+	if err := DbCon.Where("user_id = ?", user.ID).First(&reopenedTodo).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), models.TodoStatusTodo, reopenedTodo.Status)
+}
+
+func (s *TestTodoControllerSuite) TestArchive() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/archive", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Archive(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+
+	// NOTE: include_archivedを指定した場合のみ一覧に含まれること
+	res = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Index(c)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 0)
+
+	res = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos?include_archived=true", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Index(c)
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 1)
+}
+
+func (s *TestTodoControllerSuite) TestArchive_NotCompleted() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/archive", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Archive(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func (s *TestTodoControllerSuite) TestUnarchive() {
+	// NOTE: Todoのデータを作っておく
+	archivedAt := time.Now()
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, ArchivedAt: &archivedAt, UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/unarchive", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Unarchive(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	unarchivedTodo := models.Todo{}
+	if err := DbCon.First(&unarchivedTodo, todo.ID).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Nil(s.T(), unarchivedTodo.ArchivedAt)
+}
+
+func (s *TestTodoControllerSuite) TestOccurrences() {
+	// NOTE: Todoのデータを作っておく
+	dueAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", DueAt: &dueAt, RecurrenceRule: "FREQ=WEEKLY", RecurrenceStartAt: &dueAt, UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/occurrences?count=3", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Occurrences(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["occurrences"], 3)
+}
+
+func (s *TestTodoControllerSuite) TestTrash() {
+	// NOTE: ゴミ箱のTodoのデータを作っておく
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	DbCon.Delete(&todos[0])
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/trash", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Trash(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 1)
+}
+
+func (s *TestTodoControllerSuite) TestRestore() {
+	// NOTE: ゴミ箱のTodoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	DbCon.Delete(&todo)
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/restore", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Restore(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: Todoが復元されていることを確認
+	restoredTodo := models.Todo{}
+	err := DbCon.Where("user_id = ?", user.ID).First(&restoredTodo).Error
+	assert.Nil(s.T(), err)
+}
+
+func (s *TestTodoControllerSuite) TestPurge() {
+	// NOTE: ゴミ箱のTodoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	DbCon.Delete(&todo)
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/trash/"+todoId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Purge(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: Todoが物理削除されていることを確認
+	purgedTodo := models.Todo{}
+	err := DbCon.Unscoped().Where("user_id = ?", user.ID).First(&purgedTodo).Error
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoControllerSuite) TestEmptyTrash() {
+	// NOTE: ゴミ箱のTodoのデータを作っておく
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	DbCon.Delete(&todos[0])
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/trash", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.EmptyTrash(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), float64(1), responseBody["purged_count"])
+	// NOTE: ゴミ箱以外のTodoは残っていることを確認
+	var count int64
+	DbCon.Unscoped().Model(&models.Todo{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestTodoControllerSuite) TestHistory() {
+	// NOTE: Todoと履歴のデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	revisions := []models.TodoRevision{
+		{TodoID: todo.ID, Revision: 1, UserID: user.ID, Action: models.TodoRevisionActionCreate, Snapshot: models.TodoSnapshot{Title: "test title 0"}},
+		{TodoID: todo.ID, Revision: 2, UserID: user.ID, Action: models.TodoRevisionActionUpdate, Snapshot: models.TodoSnapshot{Title: "test title 1"}},
+	}
+	if err := DbCon.Create(&revisions).Error; err != nil {
+		s.T().Fatalf("failed to create test revisions %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/history", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.History(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["revisions"], 2)
+}
+
+func (s *TestTodoControllerSuite) TestRevert() {
+	// NOTE: Todoと履歴のデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusTodo, UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	revision := models.TodoRevision{TodoID: todo.ID, Revision: 1, UserID: user.ID, Action: models.TodoRevisionActionCreate, Snapshot: models.TodoSnapshot{Title: "test title 0", Content: "test content 0", Status: models.TodoStatusTodo}}
+	if err := DbCon.Create(&revision).Error; err != nil {
+		s.T().Fatalf("failed to create test revision %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "revision", Value: "1"}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/revert/1", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Revert(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: Todoが指定した版の内容に戻っていることを確認
+	revertedTodo := models.Todo{}
+	if err := DbCon.Where("user_id = ?", user.ID).First(&revertedTodo).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), "test title 0", revertedTodo.Title)
+	assert.Equal(s.T(), "test content 0", revertedTodo.Content)
+}
+
+func (s *TestTodoControllerSuite) TestPatch() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	patchTodoBody := bytes.NewBufferString("{\"title\":\"test patched title 1\"}")
+	c.Request, _ = http.NewRequest(http.MethodPatch, "/todos/"+todoId, patchTodoBody)
+	c.Request.Header.Set("Content-Type", "application/merge-patch+json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Patch(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: 指定した項目のみ更新されていることを確認
+	patchedTodo := models.Todo{}
+	if err := DbCon.Where("user_id = ?", user.ID).First(&patchedTodo).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), "test patched title 1", patchedTodo.Title)
+	assert.Equal(s.T(), "test content 1", patchedTodo.Content)
+}
+
+func (s *TestTodoControllerSuite) TestPatch_JSONPatch() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	patchTodoBody := bytes.NewBufferString("[{\"op\":\"replace\",\"path\":\"/content\",\"value\":\"test patched content 1\"}]")
+	c.Request, _ = http.NewRequest(http.MethodPatch, "/todos/"+todoId, patchTodoBody)
+	c.Request.Header.Set("Content-Type", "application/json-patch+json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Patch(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	patchedTodo := models.Todo{}
+	if err := DbCon.Where("user_id = ?", user.ID).First(&patchedTodo).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), "test title 1", patchedTodo.Title)
+	assert.Equal(s.T(), "test patched content 1", patchedTodo.Content)
+}
+
+func (s *TestTodoControllerSuite) TestPatch_UnsupportedMediaType() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodPatch, "/todos/"+todoId, bytes.NewBufferString("title=test"))
+	c.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Patch(c)
+
+	assert.Equal(s.T(), 415, res.Code)
+}
+
+func (s *TestTodoControllerSuite) TestShow_ETag() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Show(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	assert.Equal(s.T(), "\"1\"", res.Header().Get("ETag"))
+}
+
+func (s *TestTodoControllerSuite) TestShow_ETagChangesWithDependency() {
+	// NOTE: Todoとブロッカーのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	blocker := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID}
+	for _, t := range []*models.Todo{&todo, &blocker} {
+		if err := DbCon.Create(t).Error; err != nil {
+			s.T().Fatalf("failed to create test todo %v", err)
+		}
+	}
+	todoId := strconv.Itoa(todo.ID)
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Show(c)
+	etag := res.Header().Get("ETag")
+
+	if err := repositories.NewTodoDependencyRepository(DbCon).CreateTodoDependency(&models.TodoDependency{TodoID: todo.ID, BlockerID: blocker.ID}); err != nil {
+		s.T().Fatalf("failed to create test dependency %v", err)
+	}
+
+	// NOTE: ブロッカーを追加するとETagが変わり、古いETagでは304にならないこと
+	res = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	c.Request.Header.Set("If-None-Match", etag)
+	testTodoController.Show(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	assert.NotEqual(s.T(), etag, res.Header().Get("ETag"))
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), true, responseBody["todo"]["blocked"])
+}
+
+func (s *TestTodoControllerSuite) TestUpdate_PreconditionFailed() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	updateTodoBody := bytes.NewBufferString("{\"title\":\"test updated title 1\",\"content\":\"test updated content 1\"}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/todos/"+todoId, updateTodoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("If-Match", "\"2\"")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Update(c)
+
+	assert.Equal(s.T(), 412, res.Code)
+	// NOTE: Todoが更新されていないことを確認
+	notUpdatedTodo := models.Todo{}
+	if err := DbCon.Where("user_id = ?", user.ID).First(&notUpdatedTodo).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), "test title 1", notUpdatedTodo.Title)
+}
+
+func (s *TestTodoControllerSuite) TestIndex_NotModified() {
+	// NOTE: Todoのデータを作っておく
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	assert.Equal(s.T(), "private, no-cache", res.Header().Get("Cache-Control"))
+	etag := res.Header().Get("ETag")
+	assert.NotEqual(s.T(), "", etag)
+	assert.NotEqual(s.T(), "", res.Header().Get("Last-Modified"))
+
+	// NOTE: 取得したETagを指定すると304となること
+	cachedRes := httptest.NewRecorder()
+	cachedC, _ := gin.CreateTestContext(cachedRes)
+	cachedC.Request, _ = http.NewRequest(http.MethodGet, "/todos", nil)
+	cachedC.Request.Header.Set("Cookie", "token="+token)
+	cachedC.Request.Header.Set("If-None-Match", etag)
+	testTodoController.Index(cachedC)
+
+	assert.Equal(s.T(), 304, cachedRes.Code)
+	assert.Equal(s.T(), 0, cachedRes.Body.Len())
+}
+
+func (s *TestTodoControllerSuite) TestIndex_NotModified_DueFilter() {
+	// NOTE: Todoのデータを作っておく
+	dueAt := time.Now().Add(-time.Hour)
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", DueAt: &dueAt, UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos?overdue=true", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	c.Request.Header.Set("If-Modified-Since", todo.UpdatedAt.Add(time.Minute).UTC().Format(http.TimeFormat))
+	testTodoController.Index(c)
+
+	// NOTE: 実行時刻によって結果が変わるため、Last-Modifiedを返さずIf-Modified-Sinceでは304としないこと
+	assert.Equal(s.T(), 200, res.Code)
+	assert.Equal(s.T(), "", res.Header().Get("Last-Modified"))
+	assert.NotEqual(s.T(), "", res.Header().Get("ETag"))
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 1)
+}
+
+func (s *TestTodoControllerSuite) TestShow_NotModified() {
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	c.Request.Header.Set("If-Modified-Since", todo.UpdatedAt.Add(time.Minute).UTC().Format(http.TimeFormat))
+	testTodoController.Show(c)
+
+	assert.Equal(s.T(), 304, res.Code)
+}
+
+func (s *TestTodoControllerSuite) TestMove() {
+	// NOTE: Todoのデータを作っておく
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID, Position: "a"},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID, Position: "b"},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todos[1].ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	moveTodoBody := bytes.NewBufferString("{\"before_id\":" + strconv.Itoa(todos[0].ID) + "}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/move", moveTodoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Move(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: 並び順が入れ替わっていることを確認
+	movedTodos := []models.Todo{}
+	if err := DbCon.Where("user_id = ?", user.ID).Order("position ASC").Find(&movedTodos).Error; err != nil {
+		s.T().Fatalf("failed to fetch todos %v", err)
+	}
+	assert.Equal(s.T(), todos[1].ID, movedTodos[0].ID)
+}
+
+func (s *TestTodoControllerSuite) TestAssign() {
+	assignee := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "assignee@example.com"}).(*models.User)
+	if err := DbCon.Create(&assignee).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	assignTodoBody := bytes.NewBufferString("{\"assignee_id\":" + strconv.Itoa(assignee.ID) + "}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/todos/"+todoId+"/assignee", assignTodoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Assign(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: 担当者が設定されていることを確認
+	assignedTodo := models.Todo{}
+	if err := DbCon.First(&assignedTodo, todo.ID).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), assignee.ID, *assignedTodo.AssigneeID)
+}
+
+func (s *TestTodoControllerSuite) TestAssigned() {
+	owner := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "owner@example.com"}).(*models.User)
+	if err := DbCon.Create(&owner).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	// NOTE: 他のユーザから割り当てられたTodoと自身のTodoを作っておく
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: owner.ID, AssigneeID: &user.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/assigned", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Assigned(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 1)
+}
+
+func (s *TestTodoControllerSuite) TestDelete_Forbidden() {
+	owner := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "owner@example.com"}).(*models.User)
+	if err := DbCon.Create(&owner).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: owner.ID, AssigneeID: &user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/"+todoId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Delete(c)
+
+	assert.Equal(s.T(), 403, res.Code)
+}
+
+func (s *TestTodoControllerSuite) TestShare() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	// NOTE: Todoのデータを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	shareTodoBody := bytes.NewBufferString("{\"user_id\":" + strconv.Itoa(collaborator.ID) + ",\"role\":\"editor\"}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/shares", shareTodoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Share(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: 共有が登録されていることを確認
+	share := models.TodoShare{}
+	if err := DbCon.Where("todo_id = ? AND user_id = ?", todo.ID, collaborator.ID).First(&share).Error; err != nil {
+		s.T().Fatalf("failed to fetch todo share %v", err)
+	}
+	assert.Equal(s.T(), models.TodoShareRoleEditor, share.Role)
+}
+
+func (s *TestTodoControllerSuite) TestShare_Forbidden() {
+	owner := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "owner@example.com"}).(*models.User)
+	if err := DbCon.Create(&owner).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	// NOTE: 閲覧者として共有されたTodoを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: owner.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: user.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	updateTodoBody := bytes.NewBufferString("{\"title\":\"test updated title 1\",\"content\":\"test updated content 1\"}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/todos/"+todoId, updateTodoBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Update(c)
+
+	assert.Equal(s.T(), 403, res.Code)
+}
+
+func (s *TestTodoControllerSuite) TestShares() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	// NOTE: 共有済みのTodoを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: collaborator.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	param := gin.Param{Key: "id", Value: todoId}
+	c.Params = gin.Params{param}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/shares", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Shares(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["shares"], 1)
+}
+
+func (s *TestTodoControllerSuite) TestUnshare() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	// NOTE: 共有済みのTodoを作っておく
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: collaborator.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "user_id", Value: strconv.Itoa(collaborator.ID)}}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/"+todoId+"/shares/"+strconv.Itoa(collaborator.ID), nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Unshare(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	// NOTE: 共有が解除されていることを確認
+	var count int64
+	DbCon.Model(&models.TodoShare{}).Where("todo_id = ?", todo.ID).Count(&count)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestTodoControllerSuite) TestShared() {
+	owner := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "owner@example.com"}).(*models.User)
+	if err := DbCon.Create(&owner).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	// NOTE: 他のユーザから共有されたTodoと共有されていないTodoを作っておく
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: owner.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: owner.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todos[0].ID, UserID: user.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/shared", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoController.Shared(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 1)
+}
+
 func TestTodoController(t *testing.T) {
 	// テストスイートを実施
 	suite.Run(t, new(TestTodoControllerSuite))
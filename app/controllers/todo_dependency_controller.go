@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TodoDependencyController interface {
+	Create(ctx *gin.Context)
+	Index(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type todoDependencyController struct {
+	todoDependencyService services.TodoDependencyService
+	authService           services.AuthService
+}
+
+func NewTodoDependencyController(todoDependencyService services.TodoDependencyService, authService services.AuthService) TodoDependencyController {
+	return &todoDependencyController{todoDependencyService, authService}
+}
+
+func (todoDependencyController *todoDependencyController) Create(ctx *gin.Context) {
+	user, err := todoDependencyController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.AddTodoDependencyRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := todoDependencyController.todoDependencyService.AddTodoDependency(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoDependencyController *todoDependencyController) Index(ctx *gin.Context) {
+	user, err := todoDependencyController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoDependencyController.todoDependencyService.FetchBlockersList(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"blockers": result.Blockers})
+		return
+	}
+
+	switch result.ErrorType {
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoDependencyController *todoDependencyController) Delete(ctx *gin.Context) {
+	user, err := todoDependencyController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	blockerId, err := strconv.Atoi(ctx.Param("blocker_id"))
+	result := todoDependencyController.todoDependencyService.RemoveTodoDependency(id, blockerId, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
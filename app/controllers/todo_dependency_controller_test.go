@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testTodoDependencyController TodoDependencyController
+var blockerTodo models.Todo
+
+type TestTodoDependencyControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoDependencyControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	blockerTodo = models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID}
+	if err := DbCon.Create(&blockerTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	todoDependencyRepository := repositories.NewTodoDependencyRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	todoDependencyService := services.NewTodoDependencyService(todoRepository, todoShareRepository, todoDependencyRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testTodoDependencyController = NewTodoDependencyController(todoDependencyService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestTodoDependencyControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoDependencyControllerSuite) TestCreate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	createDependencyBody := bytes.NewBufferString("{\"blocker_id\":" + strconv.Itoa(blockerTodo.ID) + "}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/dependencies", createDependencyBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoDependencyController.Create(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), true, responseBody["todo"]["blocked"])
+}
+
+func (s *TestTodoDependencyControllerSuite) TestCreate_Cycle() {
+	if err := DbCon.Create(&models.TodoDependency{TodoID: blockerTodo.ID, BlockerID: todo.ID}).Error; err != nil {
+		s.T().Fatalf("failed to create test dependency %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	createDependencyBody := bytes.NewBufferString("{\"blocker_id\":" + strconv.Itoa(blockerTodo.ID) + "}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/dependencies", createDependencyBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoDependencyController.Create(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func (s *TestTodoDependencyControllerSuite) TestIndex() {
+	if err := DbCon.Create(&models.TodoDependency{TodoID: todo.ID, BlockerID: blockerTodo.ID}).Error; err != nil {
+		s.T().Fatalf("failed to create test dependency %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/dependencies", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoDependencyController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["blockers"], 1)
+}
+
+func (s *TestTodoDependencyControllerSuite) TestDelete() {
+	if err := DbCon.Create(&models.TodoDependency{TodoID: todo.ID, BlockerID: blockerTodo.ID}).Error; err != nil {
+		s.T().Fatalf("failed to create test dependency %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	blockerId := strconv.Itoa(blockerTodo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "blocker_id", Value: blockerId}}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/"+todoId+"/dependencies/"+blockerId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoDependencyController.Delete(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+}
+
+func TestTodoDependencyController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoDependencyControllerSuite))
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TodoShareLinkController interface {
+	Create(ctx *gin.Context)
+	Index(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Show(ctx *gin.Context)
+}
+
+type todoShareLinkController struct {
+	todoShareLinkService services.TodoShareLinkService
+	authService          services.AuthService
+}
+
+func NewTodoShareLinkController(todoShareLinkService services.TodoShareLinkService, authService services.AuthService) TodoShareLinkController {
+	return &todoShareLinkController{todoShareLinkService, authService}
+}
+
+func (todoShareLinkController *todoShareLinkController) Create(ctx *gin.Context) {
+	user, err := todoShareLinkController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	// NOTE: リクエストボディは省略可能
+	requestParams := dto.CreateTodoShareLinkRequest{}
+	if err := ctx.ShouldBindJSON(&requestParams); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	result := todoShareLinkController.todoShareLinkService.CreateTodoShareLink(id, requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"link": result.Link})
+		return
+	}
+
+	switch result.ErrorType {
+	case "badRequest":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": result.Error.Error()})
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoShareLinkController *todoShareLinkController) Index(ctx *gin.Context) {
+	user, err := todoShareLinkController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	result := todoShareLinkController.todoShareLinkService.FetchTodoShareLinks(id, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"links": result.Links})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (todoShareLinkController *todoShareLinkController) Delete(ctx *gin.Context) {
+	user, err := todoShareLinkController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	linkId, err := strconv.Atoi(ctx.Param("link_id"))
+	result := todoShareLinkController.todoShareLinkService.RevokeTodoShareLink(id, linkId, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"result": "revoke share link(ID: " + ctx.Param("link_id") + ") successfully"})
+		return
+	}
+
+	switch result.ErrorType {
+	case "forbidden":
+		ctx.JSON(http.StatusForbidden, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+// NOTE: 認証不要で共有リンクのTodoを閲覧専用で返す
+func (todoShareLinkController *todoShareLinkController) Show(ctx *gin.Context) {
+	requestParams := dto.FetchSharedTodoRequest{Token: ctx.Param("token"), Password: ctx.GetHeader("X-Share-Password")}
+	result := todoShareLinkController.todoShareLinkService.FetchSharedTodo(requestParams)
+
+	// NOTE: トークンを含むURLがキャッシュや遷移先に残らないようにする
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("Referrer-Policy", "no-referrer")
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"todo": result.Todo, "expires_at": result.ExpiresAt})
+		return
+	}
+
+	switch result.ErrorType {
+	case "unauthorized":
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": result.Error.Error()})
+	case "notFound":
+		ctx.JSON(http.StatusNotFound, gin.H{"error": result.Error.Error()})
+	}
+}
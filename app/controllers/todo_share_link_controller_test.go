@@ -0,0 +1,162 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testTodoShareLinkController TodoShareLinkController
+
+type TestTodoShareLinkControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoShareLinkControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	todoShareLinkRepository := repositories.NewTodoShareLinkRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	todoShareLinkService := services.NewTodoShareLinkService(todoRepository, todoShareRepository, todoShareLinkRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testTodoShareLinkController = NewTodoShareLinkController(todoShareLinkService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestTodoShareLinkControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoShareLinkControllerSuite) TestCreate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	createLinkBody := bytes.NewBufferString("{\"password\":\"secret\",\"expires_at\":\"2099-01-01T00:00:00Z\"}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/links", createLinkBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoShareLinkController.Create(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	link := responseBody["link"].(map[string]interface{})
+	assert.NotEqual(s.T(), "", link["token"])
+	assert.Equal(s.T(), true, link["password_protected"])
+	// NOTE: パスワードのハッシュはレスポンスに含まれないこと
+	assert.NotContains(s.T(), link, "PasswordHash")
+}
+
+func (s *TestTodoShareLinkControllerSuite) TestIndex() {
+	if err := DbCon.Create(&models.TodoShareLink{TodoID: todo.ID, Token: "test-token-1", UserID: user.ID}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share link %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/todos/"+todoId+"/links", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoShareLinkController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["links"], 1)
+}
+
+func (s *TestTodoShareLinkControllerSuite) TestDelete() {
+	link := models.TodoShareLink{TodoID: todo.ID, Token: "test-token-1", UserID: user.ID}
+	if err := DbCon.Create(&link).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share link %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	linkId := strconv.Itoa(link.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}, {Key: "link_id", Value: linkId}}
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/todos/"+todoId+"/links/"+linkId, nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoShareLinkController.Delete(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	var count int64
+	DbCon.Model(&models.TodoShareLink{}).Where("todo_id = ?", todo.ID).Count(&count)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestTodoShareLinkControllerSuite) TestShow() {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.DefaultCost)
+	link := models.TodoShareLink{TodoID: todo.ID, Token: "test-token-1", PasswordHash: string(hash), UserID: user.ID}
+	if err := DbCon.Create(&link).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share link %v", err)
+	}
+
+	// NOTE: 認証用のCookieなしで閲覧できること
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "token", Value: link.Token}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/shared/"+link.Token, nil)
+	c.Request.Header.Set("X-Share-Password", "secret")
+	testTodoShareLinkController.Show(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	assert.Equal(s.T(), "no-store", res.Header().Get("Cache-Control"))
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Contains(s.T(), responseBody["todo"], "Title")
+}
+
+func (s *TestTodoShareLinkControllerSuite) TestShow_WrongPassword() {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.DefaultCost)
+	link := models.TodoShareLink{TodoID: todo.ID, Token: "test-token-1", PasswordHash: string(hash), UserID: user.ID}
+	if err := DbCon.Create(&link).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share link %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "token", Value: link.Token}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/shared/"+link.Token, nil)
+	c.Request.Header.Set("X-Share-Password", "wrong")
+	testTodoShareLinkController.Show(c)
+
+	assert.Equal(s.T(), 401, res.Code)
+}
+
+func TestTodoShareLinkController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoShareLinkControllerSuite))
+}
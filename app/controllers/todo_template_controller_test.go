@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testTodoTemplateController TodoTemplateController
+
+type TestTodoTemplateControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoTemplateControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoRevisionRepository := repositories.NewTodoRevisionRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	todoTemplateRepository := repositories.NewTodoTemplateRepository(DbCon)
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+	transactionRepository := repositories.NewTransactionRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	todoService := services.NewTodoService(todoRepository, todoRevisionRepository, userRepository, todoShareRepository, notificationRepository, userSettingRepository, transactionRepository)
+	todoTemplateService := services.NewTodoTemplateService(todoTemplateRepository, todoRepository, todoShareRepository, todoService)
+
+	// NOTE: テスト対象のコントローラを設定
+	testTodoTemplateController = NewTodoTemplateController(todoTemplateService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestTodoTemplateControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoTemplateControllerSuite) TestCreate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	createTemplateBody := bytes.NewBufferString("{\"name\":\"onboarding\",\"title\":\"Onboard {{name}}\",\"checklist_items\":[\"Create account\"],\"due_offset_days\":7}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/templates", createTemplateBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoTemplateController.Create(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), "onboarding", responseBody["template"]["name"])
+}
+
+func (s *TestTodoTemplateControllerSuite) TestInstantiate() {
+	template := models.TodoTemplate{UserID: user.ID, Name: "onboarding", Title: "Onboard {{name}}", ChecklistItems: []string{"Create account"}, Tags: []string{"hr"}}
+	if err := DbCon.Create(&template).Error; err != nil {
+		s.T().Fatalf("failed to create test template %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	templateId := strconv.Itoa(template.ID)
+	c.Params = gin.Params{{Key: "id", Value: templateId}}
+	instantiateBody := bytes.NewBufferString("{\"variables\":{\"name\":\"Alice\"}}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/templates/"+templateId+"/instantiate", instantiateBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoTemplateController.Instantiate(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), "Onboard Alice", responseBody["todo"]["Title"])
+	assert.Equal(s.T(), []interface{}{"hr"}, responseBody["todo"]["tags"])
+}
+
+func (s *TestTodoTemplateControllerSuite) TestInstantiate_MissingVariables() {
+	template := models.TodoTemplate{UserID: user.ID, Name: "onboarding", Title: "Onboard {{name}}"}
+	if err := DbCon.Create(&template).Error; err != nil {
+		s.T().Fatalf("failed to create test template %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	templateId := strconv.Itoa(template.ID)
+	c.Params = gin.Params{{Key: "id", Value: templateId}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/templates/"+templateId+"/instantiate", bytes.NewBufferString("{}"))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoTemplateController.Instantiate(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func (s *TestTodoTemplateControllerSuite) TestCreateFromTodo() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	todoId := strconv.Itoa(todo.ID)
+	c.Params = gin.Params{{Key: "id", Value: todoId}}
+	c.Request, _ = http.NewRequest(http.MethodPost, "/todos/"+todoId+"/template", bytes.NewBufferString("{\"name\":\"from todo\"}"))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoTemplateController.CreateFromTodo(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), "from todo", responseBody["template"]["name"])
+	assert.Equal(s.T(), "test title 1", responseBody["template"]["title"])
+}
+
+func TestTodoTemplateController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoTemplateControllerSuite))
+}
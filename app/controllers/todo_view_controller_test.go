@@ -0,0 +1,147 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testTodoViewController TodoViewController
+
+type TestTodoViewControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoViewControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoViewRepository := repositories.NewTodoViewRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	todoViewService := services.NewTodoViewService(todoViewRepository, todoRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testTodoViewController = NewTodoViewController(todoViewService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestTodoViewControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoViewControllerSuite) TestCreate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	createViewBody := bytes.NewBufferString("{\"name\":\"high priority this week\",\"filter\":{\"priorities\":[\"high\"],\"due_from_days\":0,\"due_to_days\":6}}")
+	c.Request, _ = http.NewRequest(http.MethodPost, "/views", createViewBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoViewController.Create(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), "high priority this week", responseBody["view"]["name"])
+}
+
+func (s *TestTodoViewControllerSuite) TestIndex() {
+	if err := DbCon.Create(&models.TodoView{UserID: user.ID, Name: "high priority"}).Error; err != nil {
+		s.T().Fatalf("failed to create test view %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/views", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoViewController.Index(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["views"], 1)
+	assert.Len(s.T(), responseBody["smart_lists"], 3)
+}
+
+func (s *TestTodoViewControllerSuite) TestTodos() {
+	view := models.TodoView{UserID: user.ID, Name: "high priority", Filter: models.TodoFilter{Priorities: []string{models.TodoPriorityHigh}}}
+	if err := DbCon.Create(&view).Error; err != nil {
+		s.T().Fatalf("failed to create test view %v", err)
+	}
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID, Priority: models.TodoPriorityHigh},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	viewId := strconv.Itoa(view.ID)
+	c.Params = gin.Params{{Key: "id", Value: viewId}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/views/"+viewId+"/todos", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoViewController.Todos(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 1)
+}
+
+func (s *TestTodoViewControllerSuite) TestTodos_SmartList() {
+	dueAt := time.Now().Add(-time.Hour)
+	if err := DbCon.Create(&models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID, DueAt: &dueAt}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "id", Value: models.SmartListOverdue}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/views/overdue/todos", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoViewController.Todos(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Len(s.T(), responseBody["todos"], 1)
+}
+
+func (s *TestTodoViewControllerSuite) TestTodos_NotFound() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Params = gin.Params{{Key: "id", Value: "someday"}}
+	c.Request, _ = http.NewRequest(http.MethodGet, "/views/someday/todos", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testTodoViewController.Todos(c)
+
+	assert.Equal(s.T(), 404, res.Code)
+}
+
+func TestTodoViewController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoViewControllerSuite))
+}
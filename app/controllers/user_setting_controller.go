@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"app/dto"
+	"app/services"
+	"app/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserSettingController interface {
+	Show(ctx *gin.Context)
+	Update(ctx *gin.Context)
+}
+
+type userSettingController struct {
+	userSettingService services.UserSettingService
+	authService        services.AuthService
+}
+
+func NewUserSettingController(userSettingService services.UserSettingService, authService services.AuthService) UserSettingController {
+	return &userSettingController{userSettingService, authService}
+}
+
+func (userSettingController *userSettingController) Show(ctx *gin.Context) {
+	user, err := userSettingController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	result := userSettingController.userSettingService.FetchUserSetting(user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"setting": result.Setting})
+		return
+	}
+
+	switch result.ErrorType {
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
+
+func (userSettingController *userSettingController) Update(ctx *gin.Context) {
+	user, err := userSettingController.authService.GetAuthUser(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized error"})
+		return
+	}
+
+	// NOTE: リクエストデータを構造体に変換
+	requestParams := dto.UpdateUserSettingRequest{}
+	if err := ctx.ShouldBind(&requestParams); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err})
+		return
+	}
+	result := userSettingController.userSettingService.UpdateUserSetting(requestParams, user.ID)
+
+	if result.Error == nil {
+		ctx.JSON(http.StatusOK, gin.H{"setting": result.Setting})
+		return
+	}
+
+	switch result.ErrorType {
+	case "validationError":
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": utils.CoordinateValidationErrors(result.Error)})
+	case "internalServerError":
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": result.Error})
+	}
+}
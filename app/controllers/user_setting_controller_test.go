@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"app/models"
+	"app/repositories"
+	"app/services"
+	"app/test/factories"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var testUserSettingController UserSettingController
+
+type TestUserSettingControllerSuite struct {
+	WithDbSuite
+}
+
+func (s *TestUserSettingControllerSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+
+	authService := services.NewAuthService(userRepository)
+	userSettingService := services.NewUserSettingService(userSettingRepository, todoRepository)
+
+	// NOTE: テスト対象のコントローラを設定
+	testUserSettingController = NewUserSettingController(userSettingService, authService)
+
+	// NOTE: ログインし、tokenに値を格納
+	s.signIn()
+}
+
+func (s *TestUserSettingControllerSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestUserSettingControllerSuite) TestShow() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/settings", nil)
+	c.Request.Header.Set("Cookie", "token="+token)
+	testUserSettingController.Show(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Nil(s.T(), responseBody["setting"]["auto_archive_days"])
+}
+
+func (s *TestUserSettingControllerSuite) TestUpdate() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	updateSettingBody := bytes.NewBufferString("{\"auto_archive_days\":30}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/settings", updateSettingBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testUserSettingController.Update(c)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), float64(30), responseBody["setting"]["auto_archive_days"])
+}
+
+func (s *TestUserSettingControllerSuite) TestUpdate_Partial() {
+	autoArchiveDays := 7
+	setting := models.UserSetting{UserID: user.ID, AutoArchiveDays: &autoArchiveDays, WebhookURL: "https://example.com/hooks/reminder", MutedNotificationTypes: []string{models.NotificationTypeShared}}
+	if err := DbCon.Create(&setting).Error; err != nil {
+		s.T().Fatalf("failed to create test setting %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	updateSettingBody := bytes.NewBufferString("{\"auto_archive_days\":null}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/settings", updateSettingBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testUserSettingController.Update(c)
+
+	// NOTE: nullを指定した項目のみ更新し、省略した項目は維持すること
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]map[string]interface{})
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Nil(s.T(), responseBody["setting"]["auto_archive_days"])
+	assert.Equal(s.T(), "https://example.com/hooks/reminder", responseBody["setting"]["webhook_url"])
+	assert.Equal(s.T(), []interface{}{models.NotificationTypeShared}, responseBody["setting"]["muted_notification_types"])
+}
+
+func (s *TestUserSettingControllerSuite) TestUpdate_ValidationError() {
+	res := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(res)
+	updateSettingBody := bytes.NewBufferString("{\"auto_archive_days\":1000}")
+	c.Request, _ = http.NewRequest(http.MethodPut, "/settings", updateSettingBody)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Cookie", "token="+token)
+	testUserSettingController.Update(c)
+
+	assert.Equal(s.T(), 400, res.Code)
+}
+
+func TestUserSettingController(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestUserSettingControllerSuite))
+}
@@ -3,12 +3,38 @@ package main
 import (
 	"app/db"
 	"app/models"
+	"fmt"
 
 	"gorm.io/gorm"
 )
 
+// NOTE: 日本語も検索できるよう、全文検索のインデックスはngramパーサで作成する
+var fullTextIndexes = []struct {
+	model   interface{}
+	table   string
+	name    string
+	columns string
+}{
+	{&models.Todo{}, "todos", "idx_todos_fulltext", "title, content"},
+	{&models.Comment{}, "comments", "idx_comments_fulltext", "body"},
+}
+
 func migrate(db *gorm.DB) {
-	db.AutoMigrate(&models.User{}, &models.Todo{})
+	db.AutoMigrate(&models.User{}, &models.Todo{}, &models.ChecklistItem{}, &models.TodoRevision{}, &models.TodoShare{}, &models.TodoShareLink{}, &models.Comment{}, &models.Attachment{}, &models.TodoDependency{}, &models.TimeEntry{}, &models.TodoTag{}, &models.Board{}, &models.BoardColumn{}, &models.TodoView{}, &models.TodoTemplate{}, &models.UserSetting{}, &models.Reminder{}, &models.Notification{})
+	createFullTextIndexes(db)
+}
+
+// NOTE: gormのタグではパーサを指定できないため、未作成の場合のみSQLで作成する
+func createFullTextIndexes(db *gorm.DB) {
+	for _, index := range fullTextIndexes {
+		if db.Migrator().HasIndex(index.model, index.name) {
+			continue
+		}
+		sql := fmt.Sprintf("ALTER TABLE %s ADD FULLTEXT INDEX %s (%s) WITH PARSER ngram", index.table, index.name, index.columns)
+		if err := db.Exec(sql).Error; err != nil {
+			panic(err)
+		}
+	}
 }
 
 func main() {
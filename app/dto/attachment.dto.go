@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"app/models"
+	"io"
+)
+
+type UploadAttachmentRequest struct {
+	FileName string
+	Size     int64
+	File     io.Reader
+}
+
+type UploadAttachmentResponse struct {
+	Attachment models.Attachment
+	Error      error
+	ErrorType  string
+}
+
+type AttachmentsListResponse struct {
+	Attachments []models.Attachment
+	Error       error
+	ErrorType   string
+}
+
+// NOTE: Bodyは呼び出し側で閉じる
+type DownloadAttachmentResponse struct {
+	Attachment models.Attachment
+	Body       io.ReadCloser
+	Error      error
+	ErrorType  string
+}
+
+type DeleteAttachmentResponse struct {
+	Error     error
+	ErrorType string
+}
+
+// NOTE: FailedCountは保存先からの削除に失敗し、次回に再試行する件数
+type PurgeAttachmentsResponse struct {
+	PurgedCount int64
+	FailedCount int64
+	Error       error
+	ErrorType   string
+}
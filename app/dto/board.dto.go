@@ -0,0 +1,70 @@
+package dto
+
+import "app/models"
+
+type BoardColumnRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type CreateBoardRequest struct {
+	Name    string               `json:"name"`
+	GroupBy string               `json:"group_by"`
+	Columns []BoardColumnRequest `json:"columns"`
+}
+
+type CreateBoardResponse struct {
+	Board     models.Board
+	Error     error
+	ErrorType string
+}
+
+type BoardsListResponse struct {
+	Boards    []models.Board
+	Error     error
+	ErrorType string
+}
+
+// NOTE: 列ごとに並び順で並べたTodo
+type BoardColumnTodos struct {
+	Column models.BoardColumn `json:"column"`
+	Todos  []models.Todo      `json:"todos"`
+}
+
+type FetchBoardResponse struct {
+	Board     models.Board
+	Columns   []BoardColumnTodos
+	Error     error
+	ErrorType string
+}
+
+type UpdateBoardRequest struct {
+	Name    string               `json:"name"`
+	GroupBy string               `json:"group_by"`
+	Columns []BoardColumnRequest `json:"columns"`
+}
+
+type UpdateBoardResponse struct {
+	Board     models.Board
+	Error     error
+	ErrorType string
+}
+
+type DeleteBoardResponse struct {
+	Error     error
+	ErrorType string
+}
+
+// NOTE: before_id・after_idを省略した場合は列の移動のみ行う
+type MoveBoardCardRequest struct {
+	TodoID   int  `json:"todo_id"`
+	ColumnID int  `json:"column_id"`
+	BeforeID *int `json:"before_id"`
+	AfterID  *int `json:"after_id"`
+}
+
+type MoveBoardCardResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
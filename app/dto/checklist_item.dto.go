@@ -0,0 +1,39 @@
+package dto
+
+import "app/models"
+
+type CreateChecklistItemRequest struct {
+	Title string `json:"title"`
+}
+
+type CreateChecklistItemResponse struct {
+	ChecklistItem models.ChecklistItem
+	Error         error
+	ErrorType     string
+}
+
+type UpdateChecklistItemRequest struct {
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
+
+type UpdateChecklistItemResponse struct {
+	ChecklistItem models.ChecklistItem
+	Error         error
+	ErrorType     string
+}
+
+type ReorderChecklistItemsRequest struct {
+	ItemIDs []int `json:"item_ids"`
+}
+
+type ReorderChecklistItemsResponse struct {
+	ChecklistItems []models.ChecklistItem
+	Error          error
+	ErrorType      string
+}
+
+type DeleteChecklistItemResponse struct {
+	Error     error
+	ErrorType string
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "app/models"
+
+type CreateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+type CreateCommentResponse struct {
+	Comment   models.Comment
+	Error     error
+	ErrorType string
+}
+
+type CommentsListResponse struct {
+	Comments  []models.Comment
+	Error     error
+	ErrorType string
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+type UpdateCommentResponse struct {
+	Comment   models.Comment
+	Error     error
+	ErrorType string
+}
+
+type DeleteCommentResponse struct {
+	Error     error
+	ErrorType string
+}
@@ -0,0 +1,30 @@
+package dto
+
+import "app/models"
+
+type NotificationsListRequest struct {
+	UnreadOnly bool
+	Limit      int `validate:"min=1,max=100"`
+	Offset     int `validate:"min=0"`
+}
+
+// NOTE: Totalは絞り込み条件に一致する件数、UnreadCountは未読の件数
+type NotificationsListResponse struct {
+	Notifications []models.Notification
+	Total         int64
+	UnreadCount   int64
+	Error         error
+	ErrorType     string
+}
+
+type ReadNotificationResponse struct {
+	Notification models.Notification
+	Error        error
+	ErrorType    string
+}
+
+type ReadAllNotificationsResponse struct {
+	ReadCount int64
+	Error     error
+	ErrorType string
+}
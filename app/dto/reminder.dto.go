@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"app/models"
+	"time"
+)
+
+// NOTE: remind_at・offset_minutesのいずれかを指定する(channelsの省略時はアプリ内通知のみ)
+type CreateReminderRequest struct {
+	RemindAt      *time.Time `json:"remind_at"`
+	OffsetMinutes *int       `json:"offset_minutes"`
+	Channels      []string   `json:"channels"`
+}
+
+type CreateReminderResponse struct {
+	Reminder  models.Reminder
+	Error     error
+	ErrorType string
+}
+
+type RemindersListResponse struct {
+	Reminders []models.Reminder
+	Error     error
+	ErrorType string
+}
+
+type DeleteReminderResponse struct {
+	Error     error
+	ErrorType string
+}
+
+type DeliverRemindersResponse struct {
+	SentCount   int
+	FailedCount int
+	Error       error
+	ErrorType   string
+}
@@ -0,0 +1,23 @@
+package dto
+
+type SearchRequest struct {
+	Query  string `validate:"required,max=255"`
+	Limit  int    `validate:"min=1,max=100"`
+	Offset int    `validate:"min=0"`
+}
+
+// NOTE: TitleはTodoのタイトル全体を、Snippetは一致箇所周辺の抜粋をハイライトしたHTML
+type SearchHit struct {
+	Type      string  `json:"type"`
+	TodoID    int     `json:"todo_id"`
+	CommentID *int    `json:"comment_id,omitempty"`
+	Title     string  `json:"title"`
+	Snippet   string  `json:"snippet"`
+	Score     float64 `json:"score"`
+}
+
+type SearchResponse struct {
+	Hits      []SearchHit
+	Error     error
+	ErrorType string
+}
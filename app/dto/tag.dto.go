@@ -0,0 +1,19 @@
+package dto
+
+import "app/models"
+
+type SetTodoTagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+type SetTodoTagsResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+type TagsListResponse struct {
+	Tags      []string
+	Error     error
+	ErrorType string
+}
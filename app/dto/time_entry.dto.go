@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"app/models"
+	"time"
+)
+
+type StartTimerRequest struct {
+	Note string `json:"note"`
+}
+
+type StartTimerResponse struct {
+	TimeEntry models.TimeEntry
+	Error     error
+	ErrorType string
+}
+
+type StopTimerResponse struct {
+	TimeEntry models.TimeEntry
+	Error     error
+	ErrorType string
+}
+
+type CreateTimeEntryRequest struct {
+	StartedAt *time.Time `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	Note      string     `json:"note"`
+}
+
+type CreateTimeEntryResponse struct {
+	TimeEntry models.TimeEntry
+	Error     error
+	ErrorType string
+}
+
+type TimeEntriesListResponse struct {
+	TimeEntries []models.TimeEntry
+	Error       error
+	ErrorType   string
+}
+
+// NOTE: Untilは集計対象に含まない(To日付の翌日0時)
+type TimeReportRequest struct {
+	From  time.Time
+	Until time.Time
+}
+
+type TimeReportResponse struct {
+	Report    models.TimeReport
+	Error     error
+	ErrorType string
+}
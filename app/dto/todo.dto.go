@@ -1,10 +1,18 @@
 package dto
 
-import "app/models"
+import (
+	"app/models"
+	"time"
+)
 
 type CreateTodoRequest struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	Title          string     `json:"title"`
+	Content        string     `json:"content"`
+	Priority       string     `json:"priority"`
+	DueAt          *time.Time `json:"due_at"`
+	RecurrenceRule string     `json:"recurrence_rule"`
+	Tags           []string   `json:"tags"`
+	ChecklistItems []string   `json:"checklist_items"`
 }
 
 type CreateTodoResponse struct {
@@ -13,21 +21,85 @@ type CreateTodoResponse struct {
 	ErrorType string
 }
 
+type QuickAddTodoRequest struct {
+	Text string `json:"text"`
+}
+
+// NOTE: Parsedは入力から読み取った作成内容
+type QuickAddTodoResponse struct {
+	Todo      models.Todo
+	Parsed    CreateTodoRequest
+	Error     error
+	ErrorType string
+}
+
 type TodosListResponse struct {
+	Todos          []models.Todo
+	ETag           string
+	LastModifiedAt time.Time
+	Error          error
+	ErrorType      string
+}
+
+type FetchTodoResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+type AssignedTodosListResponse struct {
 	Todos     []models.Todo
 	Error     error
 	ErrorType string
 }
 
-type FetchTodoResponse struct {
+// NOTE: AssigneeIDにnullを指定すると担当者を外す
+type AssignTodoRequest struct {
+	AssigneeID *int `json:"assignee_id"`
+}
+
+type AssignTodoResponse struct {
 	Todo      models.Todo
 	Error     error
 	ErrorType string
 }
 
+type SharedTodosListResponse struct {
+	Todos     []models.Todo
+	Error     error
+	ErrorType string
+}
+
+type ShareTodoRequest struct {
+	UserID int    `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+type ShareTodoResponse struct {
+	Share     models.TodoShare
+	Error     error
+	ErrorType string
+}
+
+type TodoSharesResponse struct {
+	Shares    []models.TodoShare
+	Error     error
+	ErrorType string
+}
+
+type RevokeTodoShareResponse struct {
+	Error     error
+	ErrorType string
+}
+
+// NOTE: PUTは全項目の置き換えとし、省略した項目は空の値で更新する(一部の項目のみ更新する場合はPATCHを使う)
 type UpdateTodoRequest struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	Title          string     `json:"title"`
+	Content        string     `json:"content"`
+	Priority       string     `json:"priority"`
+	DueAt          *time.Time `json:"due_at"`
+	RecurrenceRule string     `json:"recurrence_rule"`
+	IfMatch        string     `json:"-"`
 }
 
 type UpdateTodoResponse struct {
@@ -36,7 +108,111 @@ type UpdateTodoResponse struct {
 	ErrorType string
 }
 
+const (
+	PatchTypeMergePatch = "merge-patch"
+	PatchTypeJSONPatch  = "json-patch"
+)
+
+// NOTE: PatchTypeに応じてPatchをJSON Merge Patch(RFC 7396)またはJSON Patch(RFC 6902)として解釈する
+type PatchTodoRequest struct {
+	PatchType string
+	Patch     []byte
+	IfMatch   string
+}
+
+type PatchTodoResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+// NOTE: BeforeIDのTodoの直前、またはAfterIDのTodoの直後に移動する(両方指定した場合はその間)
+type MoveTodoRequest struct {
+	BeforeID *int `json:"before_id"`
+	AfterID  *int `json:"after_id"`
+}
+
+type MoveTodoResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+type DeleteTodoRequest struct {
+	IfMatch string
+}
+
 type DeleteTodoResponse struct {
 	Error     error
 	ErrorType string
 }
+
+type CompleteTodoRequest struct {
+	CompleteChecklistItems bool `json:"complete_checklist_items"`
+}
+
+type CompleteTodoResponse struct {
+	Todo      models.Todo
+	NextTodo  *models.Todo
+	Error     error
+	ErrorType string
+}
+
+type ReopenTodoResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+type ArchiveTodoResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+type UnarchiveTodoResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+type TodoOccurrencesResponse struct {
+	Occurrences []time.Time
+	Error       error
+	ErrorType   string
+}
+
+type TrashedTodosListResponse struct {
+	Todos     []models.Todo
+	Error     error
+	ErrorType string
+}
+
+type RestoreTodoResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+type PurgeTodoResponse struct {
+	Error     error
+	ErrorType string
+}
+
+type PurgeTrashResponse struct {
+	PurgedCount int64
+	Error       error
+	ErrorType   string
+}
+
+type TodoHistoryResponse struct {
+	Revisions []models.TodoRevision
+	Error     error
+	ErrorType string
+}
+
+type RevertTodoResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"app/models"
+	"time"
+)
+
+const (
+	BulkTodoModeAtomic  = "atomic"
+	BulkTodoModePartial = "partial"
+
+	BulkTodoOpCreate   = "create"
+	BulkTodoOpUpdate   = "update"
+	BulkTodoOpDelete   = "delete"
+	BulkTodoOpComplete = "complete"
+
+	BulkTodoStatusSucceeded  = "succeeded"
+	BulkTodoStatusFailed     = "failed"
+	BulkTodoStatusRolledBack = "rolled_back"
+	BulkTodoStatusSkipped    = "skipped"
+)
+
+type BulkTodoOperation struct {
+	Op                     string     `json:"op"`
+	ID                     int        `json:"id"`
+	Title                  string     `json:"title"`
+	Content                string     `json:"content"`
+	Priority               string     `json:"priority"`
+	DueAt                  *time.Time `json:"due_at"`
+	RecurrenceRule         string     `json:"recurrence_rule"`
+	CompleteChecklistItems bool       `json:"complete_checklist_items"`
+	IfMatch                string     `json:"if_match"`
+}
+
+// NOTE: Modeがatomicの場合は1件でも失敗すると全件ロールバックし、partialの場合は失敗した操作のみロールバックする
+type BulkTodoRequest struct {
+	Mode       string              `json:"mode"`
+	Operations []BulkTodoOperation `json:"operations"`
+}
+
+// NOTE: ErrorはバリデーションエラーであればCoordinateValidationErrorsの形式、それ以外はメッセージ
+type BulkTodoResult struct {
+	Index     int          `json:"index"`
+	Op        string       `json:"op"`
+	Status    string       `json:"status"`
+	Todo      *models.Todo `json:"todo,omitempty"`
+	Error     interface{}  `json:"error,omitempty"`
+	ErrorType string       `json:"error_type,omitempty"`
+}
+
+type BulkTodoResponse struct {
+	Results   []BulkTodoResult
+	Error     error
+	ErrorType string
+}
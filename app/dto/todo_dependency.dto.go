@@ -0,0 +1,25 @@
+package dto
+
+import "app/models"
+
+type AddTodoDependencyRequest struct {
+	BlockerID int `json:"blocker_id"`
+}
+
+type AddTodoDependencyResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+type BlockersListResponse struct {
+	Blockers  []models.Todo
+	Error     error
+	ErrorType string
+}
+
+type RemoveTodoDependencyResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"app/models"
+	"time"
+)
+
+// NOTE: ExpiresAt・Passwordは省略可能
+type CreateTodoShareLinkRequest struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+	Password  string     `json:"password"`
+}
+
+type CreateTodoShareLinkResponse struct {
+	Link      models.TodoShareLink
+	Error     error
+	ErrorType string
+}
+
+type TodoShareLinksResponse struct {
+	Links     []models.TodoShareLink
+	Error     error
+	ErrorType string
+}
+
+type RevokeTodoShareLinkResponse struct {
+	Error     error
+	ErrorType string
+}
+
+type FetchSharedTodoRequest struct {
+	Token    string
+	Password string
+}
+
+type FetchSharedTodoResponse struct {
+	Todo      models.Todo
+	ExpiresAt *time.Time
+	Error     error
+	ErrorType string
+}
@@ -0,0 +1,74 @@
+package dto
+
+import "app/models"
+
+type CreateTodoTemplateRequest struct {
+	Name           string   `json:"name"`
+	Title          string   `json:"title"`
+	Content        string   `json:"content"`
+	Priority       string   `json:"priority"`
+	ChecklistItems []string `json:"checklist_items"`
+	Tags           []string `json:"tags"`
+	DueOffsetDays  *int     `json:"due_offset_days"`
+}
+
+type CreateTodoTemplateResponse struct {
+	Template  models.TodoTemplate
+	Error     error
+	ErrorType string
+}
+
+type TodoTemplatesListResponse struct {
+	Templates []models.TodoTemplate
+	Error     error
+	ErrorType string
+}
+
+type FetchTodoTemplateResponse struct {
+	Template  models.TodoTemplate
+	Error     error
+	ErrorType string
+}
+
+type UpdateTodoTemplateRequest struct {
+	Name           string   `json:"name"`
+	Title          string   `json:"title"`
+	Content        string   `json:"content"`
+	Priority       string   `json:"priority"`
+	ChecklistItems []string `json:"checklist_items"`
+	Tags           []string `json:"tags"`
+	DueOffsetDays  *int     `json:"due_offset_days"`
+}
+
+type UpdateTodoTemplateResponse struct {
+	Template  models.TodoTemplate
+	Error     error
+	ErrorType string
+}
+
+type DeleteTodoTemplateResponse struct {
+	Error     error
+	ErrorType string
+}
+
+// NOTE: Variablesには雛形に含まれる全ての変数の値を指定する
+type InstantiateTodoTemplateRequest struct {
+	Variables map[string]string `json:"variables"`
+}
+
+type InstantiateTodoTemplateResponse struct {
+	Todo      models.Todo
+	Error     error
+	ErrorType string
+}
+
+// NOTE: Nameを省略した場合はTodoのタイトルを雛形の名前とする
+type SaveTodoAsTemplateRequest struct {
+	Name string `json:"name"`
+}
+
+type SaveTodoAsTemplateResponse struct {
+	Template  models.TodoTemplate
+	Error     error
+	ErrorType string
+}
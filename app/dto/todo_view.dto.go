@@ -0,0 +1,49 @@
+package dto
+
+import "app/models"
+
+type CreateTodoViewRequest struct {
+	Name   string            `json:"name"`
+	Filter models.TodoFilter `json:"filter"`
+}
+
+type CreateTodoViewResponse struct {
+	View      models.TodoView
+	Error     error
+	ErrorType string
+}
+
+type TodoViewsListResponse struct {
+	Views      []models.TodoView
+	SmartLists []models.SmartList
+	Error      error
+	ErrorType  string
+}
+
+type FetchTodoViewResponse struct {
+	View      models.TodoView
+	Error     error
+	ErrorType string
+}
+
+type UpdateTodoViewRequest struct {
+	Name   string            `json:"name"`
+	Filter models.TodoFilter `json:"filter"`
+}
+
+type UpdateTodoViewResponse struct {
+	View      models.TodoView
+	Error     error
+	ErrorType string
+}
+
+type DeleteTodoViewResponse struct {
+	Error     error
+	ErrorType string
+}
+
+type TodoViewTodosListResponse struct {
+	Todos     []models.Todo
+	Error     error
+	ErrorType string
+}
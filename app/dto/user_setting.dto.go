@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"app/models"
+	"encoding/json"
+)
+
+type FetchUserSettingResponse struct {
+	Setting   models.UserSetting
+	Error     error
+	ErrorType string
+}
+
+// NOTE: 省略した項目は更新しない(自動アーカイブはnullを指定すると無効になる)
+type UpdateUserSettingRequest struct {
+	AutoArchiveDays        NullableInt `json:"auto_archive_days"`
+	WebhookURL             *string     `json:"webhook_url"`
+	MutedNotificationTypes *[]string   `json:"muted_notification_types"`
+}
+
+// NOTE: JSONで省略された項目とnullを区別する(Setがfalseの場合は省略された項目)
+type NullableInt struct {
+	Value *int
+	Set   bool
+}
+
+func (n *NullableInt) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	return json.Unmarshal(data, &n.Value)
+}
+
+type UpdateUserSettingResponse struct {
+	Setting   models.UserSetting
+	Error     error
+	ErrorType string
+}
+
+type AutoArchiveTodosResponse struct {
+	ArchivedCount int64
+	Error         error
+	ErrorType     string
+}
@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"app/services"
+	"log"
+	"time"
+)
+
+type AttachmentCleanupJob interface {
+	Run()
+	Start(interval time.Duration)
+}
+
+type attachmentCleanupJob struct {
+	attachmentService services.AttachmentService
+}
+
+func NewAttachmentCleanupJob(attachmentService services.AttachmentService) AttachmentCleanupJob {
+	return &attachmentCleanupJob{attachmentService}
+}
+
+// NOTE: 物理削除されたTodoに残った添付ファイルを削除する
+func (aj *attachmentCleanupJob) Run() {
+	result := aj.attachmentService.PurgeOrphanedAttachments()
+	if result.Error != nil {
+		log.Printf("failed to purge orphaned attachments: %v", result.Error)
+		return
+	}
+	log.Printf("purged %d orphaned attachments (%d failed)", result.PurgedCount, result.FailedCount)
+}
+
+func (aj *attachmentCleanupJob) Start(interval time.Duration) {
+	go runEvery(interval, aj.Run, nil)
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"app/dto"
+	"app/services"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockAttachmentService struct {
+	services.AttachmentService
+	mock.Mock
+}
+
+func (_m *MockAttachmentService) PurgeOrphanedAttachments() *dto.PurgeAttachmentsResponse {
+	ret := _m.Called()
+	return ret.Get(0).(*dto.PurgeAttachmentsResponse)
+}
+
+type TestAttachmentCleanupJobSuite struct {
+	suite.Suite
+}
+
+func (s *TestAttachmentCleanupJobSuite) TestRun() {
+	mockAttachmentService := new(MockAttachmentService)
+	mockAttachmentService.On("PurgeOrphanedAttachments").Return(&dto.PurgeAttachmentsResponse{PurgedCount: 3, Error: nil, ErrorType: ""})
+
+	job := NewAttachmentCleanupJob(mockAttachmentService)
+	job.Run()
+
+	mockAttachmentService.AssertCalled(s.T(), "PurgeOrphanedAttachments")
+}
+
+func (s *TestAttachmentCleanupJobSuite) TestRun_Error() {
+	mockAttachmentService := new(MockAttachmentService)
+	mockAttachmentService.On("PurgeOrphanedAttachments").Return(&dto.PurgeAttachmentsResponse{PurgedCount: 0, Error: errors.New("db error"), ErrorType: "internalServerError"})
+
+	job := NewAttachmentCleanupJob(mockAttachmentService)
+
+	assert.NotPanics(s.T(), job.Run)
+	mockAttachmentService.AssertNumberOfCalls(s.T(), "PurgeOrphanedAttachments", 1)
+}
+
+func (s *TestAttachmentCleanupJobSuite) TestStart() {
+	ran := make(chan struct{}, 1)
+	mockAttachmentService := new(MockAttachmentService)
+	mockAttachmentService.On("PurgeOrphanedAttachments").Return(&dto.PurgeAttachmentsResponse{PurgedCount: 3, Error: nil, ErrorType: ""}).Run(func(args mock.Arguments) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+	})
+
+	NewAttachmentCleanupJob(mockAttachmentService).Start(time.Hour)
+
+	// NOTE: 共通のランナーから起動直後に実行されること
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		s.T().Fatal("job was not run on start")
+	}
+}
+
+func TestAttachmentCleanupJob(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestAttachmentCleanupJobSuite))
+}
@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"app/services"
+	"log"
+	"time"
+)
+
+type AutoArchiveJob interface {
+	Run()
+	Start(interval time.Duration)
+}
+
+type autoArchiveJob struct {
+	userSettingService services.UserSettingService
+}
+
+func NewAutoArchiveJob(userSettingService services.UserSettingService) AutoArchiveJob {
+	return &autoArchiveJob{userSettingService}
+}
+
+// NOTE: 自動アーカイブを設定しているユーザの完了済みTodoをアーカイブする
+func (aj *autoArchiveJob) Run() {
+	result := aj.userSettingService.AutoArchiveTodos()
+	if result.Error != nil {
+		log.Printf("failed to archive completed todos: %v", result.Error)
+		return
+	}
+	log.Printf("archived %d completed todos", result.ArchivedCount)
+}
+
+func (aj *autoArchiveJob) Start(interval time.Duration) {
+	go runEvery(interval, aj.Run, nil)
+}
@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"app/dto"
+	"app/services"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockUserSettingService struct {
+	services.UserSettingService
+	mock.Mock
+}
+
+func (_m *MockUserSettingService) AutoArchiveTodos() *dto.AutoArchiveTodosResponse {
+	ret := _m.Called()
+	return ret.Get(0).(*dto.AutoArchiveTodosResponse)
+}
+
+type TestAutoArchiveJobSuite struct {
+	suite.Suite
+}
+
+func (s *TestAutoArchiveJobSuite) TestRun() {
+	mockUserSettingService := new(MockUserSettingService)
+	mockUserSettingService.On("AutoArchiveTodos").Return(&dto.AutoArchiveTodosResponse{ArchivedCount: 3, Error: nil, ErrorType: ""})
+
+	job := NewAutoArchiveJob(mockUserSettingService)
+	job.Run()
+
+	mockUserSettingService.AssertCalled(s.T(), "AutoArchiveTodos")
+}
+
+func (s *TestAutoArchiveJobSuite) TestRun_Error() {
+	mockUserSettingService := new(MockUserSettingService)
+	mockUserSettingService.On("AutoArchiveTodos").Return(&dto.AutoArchiveTodosResponse{ArchivedCount: 0, Error: errors.New("db error"), ErrorType: "internalServerError"})
+
+	job := NewAutoArchiveJob(mockUserSettingService)
+
+	assert.NotPanics(s.T(), job.Run)
+	mockUserSettingService.AssertNumberOfCalls(s.T(), "AutoArchiveTodos", 1)
+}
+
+func (s *TestAutoArchiveJobSuite) TestStart() {
+	ran := make(chan struct{}, 1)
+	mockUserSettingService := new(MockUserSettingService)
+	mockUserSettingService.On("AutoArchiveTodos").Return(&dto.AutoArchiveTodosResponse{ArchivedCount: 3, Error: nil, ErrorType: ""}).Run(func(args mock.Arguments) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+	})
+
+	NewAutoArchiveJob(mockUserSettingService).Start(time.Hour)
+
+	// NOTE: 共通のランナーから起動直後に実行されること
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		s.T().Fatal("job was not run on start")
+	}
+}
+
+func TestAutoArchiveJob(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestAutoArchiveJobSuite))
+}
@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"app/services"
+	"log"
+	"time"
+)
+
+type ReminderJob interface {
+	Run()
+	Start(interval time.Duration)
+}
+
+type reminderJob struct {
+	reminderService services.ReminderService
+}
+
+func NewReminderJob(reminderService services.ReminderService) ReminderJob {
+	return &reminderJob{reminderService}
+}
+
+// NOTE: 通知日時を過ぎたリマインダーを配信する(送信状況はDBに記録するため、再起動しても送り漏れ・二重送信しない)
+func (rj *reminderJob) Run() {
+	result := rj.reminderService.DeliverDueReminders()
+	if result.Error != nil {
+		log.Printf("failed to deliver reminders: %v", result.Error)
+		return
+	}
+	if result.SentCount > 0 || result.FailedCount > 0 {
+		log.Printf("delivered %d reminders (%d failed)", result.SentCount, result.FailedCount)
+	}
+}
+
+func (rj *reminderJob) Start(interval time.Duration) {
+	go runEvery(interval, rj.Run, nil)
+}
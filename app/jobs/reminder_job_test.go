@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"app/dto"
+	"app/services"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockReminderService struct {
+	services.ReminderService
+	mock.Mock
+}
+
+func (_m *MockReminderService) DeliverDueReminders() *dto.DeliverRemindersResponse {
+	ret := _m.Called()
+	return ret.Get(0).(*dto.DeliverRemindersResponse)
+}
+
+type TestReminderJobSuite struct {
+	suite.Suite
+}
+
+func (s *TestReminderJobSuite) TestRun() {
+	mockReminderService := new(MockReminderService)
+	mockReminderService.On("DeliverDueReminders").Return(&dto.DeliverRemindersResponse{SentCount: 2, FailedCount: 1, Error: nil, ErrorType: ""})
+
+	job := NewReminderJob(mockReminderService)
+	job.Run()
+
+	mockReminderService.AssertCalled(s.T(), "DeliverDueReminders")
+}
+
+func (s *TestReminderJobSuite) TestRun_Error() {
+	mockReminderService := new(MockReminderService)
+	mockReminderService.On("DeliverDueReminders").Return(&dto.DeliverRemindersResponse{SentCount: 0, FailedCount: 0, Error: errors.New("db error"), ErrorType: "internalServerError"})
+
+	job := NewReminderJob(mockReminderService)
+
+	assert.NotPanics(s.T(), job.Run)
+	mockReminderService.AssertNumberOfCalls(s.T(), "DeliverDueReminders", 1)
+}
+
+func (s *TestReminderJobSuite) TestStart() {
+	ran := make(chan struct{}, 1)
+	mockReminderService := new(MockReminderService)
+	mockReminderService.On("DeliverDueReminders").Return(&dto.DeliverRemindersResponse{SentCount: 2, FailedCount: 1, Error: nil, ErrorType: ""}).Run(func(args mock.Arguments) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+	})
+
+	NewReminderJob(mockReminderService).Start(time.Hour)
+
+	// NOTE: 共通のランナーから起動直後に実行されること
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		s.T().Fatal("job was not run on start")
+	}
+}
+
+func TestReminderJob(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestReminderJobSuite))
+}
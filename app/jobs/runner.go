@@ -0,0 +1,18 @@
+package jobs
+
+import "time"
+
+// NOTE: 起動直後に1回実行し、以降はintervalごとに実行する(stopを閉じると終了する)
+func runEvery(interval time.Duration, run func(), stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		run()
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package jobs
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestRunnerSuite struct {
+	suite.Suite
+}
+
+func (s *TestRunnerSuite) TestRunEvery() {
+	var count atomic.Int32
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		runEvery(10*time.Millisecond, func() { count.Add(1) }, stop)
+		close(done)
+	}()
+
+	// NOTE: 起動直後に実行され、以降も繰り返し実行されること
+	assert.Eventually(s.T(), func() bool { return count.Load() >= 3 }, time.Second, time.Millisecond)
+	close(stop)
+	assert.Eventually(s.T(), func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond)
+}
+
+func (s *TestRunnerSuite) TestRunEvery_RunsImmediately() {
+	ran := make(chan struct{}, 1)
+	stop := make(chan struct{})
+	defer close(stop)
+	go runEvery(time.Hour, func() {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+	}, stop)
+
+	// NOTE: intervalを待たずに1回目が実行されること
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		s.T().Fatal("run was not called immediately")
+	}
+}
+
+func TestRunner(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestRunnerSuite))
+}
@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"app/services"
+	"log"
+	"time"
+)
+
+type TrashPurgeJob interface {
+	Run()
+	Start(interval time.Duration)
+}
+
+type trashPurgeJob struct {
+	todoService services.TodoService
+	retention   time.Duration
+}
+
+func NewTrashPurgeJob(todoService services.TodoService, retention time.Duration) TrashPurgeJob {
+	return &trashPurgeJob{todoService, retention}
+}
+
+// NOTE: 保持期間を過ぎたゴミ箱のTodoを物理削除する
+func (tj *trashPurgeJob) Run() {
+	result := tj.todoService.PurgeExpiredTrash(tj.retention)
+	if result.Error != nil {
+		log.Printf("failed to purge trashed todos: %v", result.Error)
+		return
+	}
+	log.Printf("purged %d trashed todos", result.PurgedCount)
+}
+
+func (tj *trashPurgeJob) Start(interval time.Duration) {
+	go runEvery(interval, tj.Run, nil)
+}
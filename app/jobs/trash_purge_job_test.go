@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"app/dto"
+	"app/services"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockTodoService struct {
+	services.TodoService
+	mock.Mock
+}
+
+func (_m *MockTodoService) PurgeExpiredTrash(retention time.Duration) *dto.PurgeTrashResponse {
+	ret := _m.Called(retention)
+	return ret.Get(0).(*dto.PurgeTrashResponse)
+}
+
+type TestTrashPurgeJobSuite struct {
+	suite.Suite
+}
+
+func (s *TestTrashPurgeJobSuite) TestRun() {
+	mockTodoService := new(MockTodoService)
+	mockTodoService.On("PurgeExpiredTrash", 30*24*time.Hour).Return(&dto.PurgeTrashResponse{PurgedCount: 2, Error: nil, ErrorType: ""})
+
+	job := NewTrashPurgeJob(mockTodoService, 30*24*time.Hour)
+	job.Run()
+
+	mockTodoService.AssertCalled(s.T(), "PurgeExpiredTrash", 30*24*time.Hour)
+}
+
+func (s *TestTrashPurgeJobSuite) TestRun_Error() {
+	mockTodoService := new(MockTodoService)
+	mockTodoService.On("PurgeExpiredTrash", time.Hour).Return(&dto.PurgeTrashResponse{PurgedCount: 0, Error: errors.New("db error"), ErrorType: "internalServerError"})
+
+	job := NewTrashPurgeJob(mockTodoService, time.Hour)
+
+	assert.NotPanics(s.T(), job.Run)
+	mockTodoService.AssertNumberOfCalls(s.T(), "PurgeExpiredTrash", 1)
+}
+
+func (s *TestTrashPurgeJobSuite) TestStart() {
+	ran := make(chan struct{}, 1)
+	mockTodoService := new(MockTodoService)
+	mockTodoService.On("PurgeExpiredTrash", time.Hour).Return(&dto.PurgeTrashResponse{PurgedCount: 2, Error: nil, ErrorType: ""}).Run(func(args mock.Arguments) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+	})
+
+	NewTrashPurgeJob(mockTodoService, time.Hour).Start(time.Hour)
+
+	// NOTE: 共通のランナーから起動直後に実行されること
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		s.T().Fatal("job was not run on start")
+	}
+}
+
+func TestTrashPurgeJob(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTrashPurgeJobSuite))
+}
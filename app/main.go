@@ -2,33 +2,111 @@ package main
 
 import (
 	"strconv"
+	"time"
 
 	"app/config"
 	"app/controllers"
 	"app/db"
+	"app/jobs"
+	"app/notifier"
 	"app/repositories"
 	"app/routers"
+	"app/search"
 	"app/services"
+	"app/storage"
 
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
 	dbCon := db.Init()
+	attachmentStorage := storage.Init()
+	searcher := search.NewMySQLSearcher(dbCon)
 
 	// repository
 	userRepository := repositories.NewUserRepository(dbCon)
 	todoRepository := repositories.NewTodoRepository(dbCon)
+	checklistItemRepository := repositories.NewChecklistItemRepository(dbCon)
+	todoRevisionRepository := repositories.NewTodoRevisionRepository(dbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(dbCon)
+	todoShareLinkRepository := repositories.NewTodoShareLinkRepository(dbCon)
+	commentRepository := repositories.NewCommentRepository(dbCon)
+	attachmentRepository := repositories.NewAttachmentRepository(dbCon)
+	todoDependencyRepository := repositories.NewTodoDependencyRepository(dbCon)
+	timeEntryRepository := repositories.NewTimeEntryRepository(dbCon)
+	tagRepository := repositories.NewTagRepository(dbCon)
+	boardRepository := repositories.NewBoardRepository(dbCon)
+	todoViewRepository := repositories.NewTodoViewRepository(dbCon)
+	todoTemplateRepository := repositories.NewTodoTemplateRepository(dbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(dbCon)
+	reminderRepository := repositories.NewReminderRepository(dbCon)
+	notificationRepository := repositories.NewNotificationRepository(dbCon)
+	transactionRepository := repositories.NewTransactionRepository(dbCon)
 
 	// service
 	authService := services.NewAuthService(userRepository)
-	todoService := services.NewTodoService(todoRepository)
+	todoService := services.NewTodoService(todoRepository, todoRevisionRepository, userRepository, todoShareRepository, notificationRepository, userSettingRepository, transactionRepository)
+	checklistItemService := services.NewChecklistItemService(todoRepository, todoShareRepository, checklistItemRepository)
+	todoBulkService := services.NewTodoBulkService(transactionRepository)
+	todoShareLinkService := services.NewTodoShareLinkService(todoRepository, todoShareRepository, todoShareLinkRepository)
+	commentService := services.NewCommentService(todoRepository, todoShareRepository, commentRepository, notificationRepository, userSettingRepository)
+	todoDependencyService := services.NewTodoDependencyService(todoRepository, todoShareRepository, todoDependencyRepository)
+	timeEntryService := services.NewTimeEntryService(todoRepository, todoShareRepository, timeEntryRepository)
+	tagService := services.NewTagService(todoRepository, todoShareRepository, tagRepository)
+	boardService := services.NewBoardService(boardRepository, todoRepository, transactionRepository)
+	todoViewService := services.NewTodoViewService(todoViewRepository, todoRepository)
+	todoTemplateService := services.NewTodoTemplateService(todoTemplateRepository, todoRepository, todoShareRepository, todoService)
+	userSettingService := services.NewUserSettingService(userSettingRepository, todoRepository)
+	searchService := services.NewSearchService(searcher)
+	reminderService := services.NewReminderService(todoRepository, todoShareRepository, reminderRepository, userSettingRepository, notifier.Init(notificationRepository))
+	notificationService := services.NewNotificationService(notificationRepository)
+	attachmentService := services.NewAttachmentService(todoRepository, todoShareRepository, attachmentRepository, attachmentStorage, int64(config.Config.AttachmentMaxSizeMB)*1024*1024)
 
 	// controller
 	authController := controllers.NewAuthController(authService)
 	todoController := controllers.NewTodoController(todoService, authService)
+	checklistItemController := controllers.NewChecklistItemController(checklistItemService, authService)
+	todoBulkController := controllers.NewTodoBulkController(todoBulkService, authService)
+	todoShareLinkController := controllers.NewTodoShareLinkController(todoShareLinkService, authService)
+	commentController := controllers.NewCommentController(commentService, authService)
+	todoDependencyController := controllers.NewTodoDependencyController(todoDependencyService, authService)
+	timeEntryController := controllers.NewTimeEntryController(timeEntryService, authService)
+	tagController := controllers.NewTagController(tagService, authService)
+	boardController := controllers.NewBoardController(boardService, authService)
+	todoViewController := controllers.NewTodoViewController(todoViewService, authService)
+	todoTemplateController := controllers.NewTodoTemplateController(todoTemplateService, authService)
+	userSettingController := controllers.NewUserSettingController(userSettingService, authService)
+	searchController := controllers.NewSearchController(searchService, authService)
+	reminderController := controllers.NewReminderController(reminderService, authService)
+	notificationController := controllers.NewNotificationController(notificationService, authService)
+	attachmentController := controllers.NewAttachmentController(attachmentService, authService)
 	authRouter := routers.NewAuthRouter(authController)
 	todoRouter := routers.NewTodoRouter(todoController)
+	checklistItemRouter := routers.NewChecklistItemRouter(checklistItemController)
+	todoBulkRouter := routers.NewTodoBulkRouter(todoBulkController)
+	todoShareLinkRouter := routers.NewTodoShareLinkRouter(todoShareLinkController)
+	commentRouter := routers.NewCommentRouter(commentController)
+	todoDependencyRouter := routers.NewTodoDependencyRouter(todoDependencyController)
+	timeEntryRouter := routers.NewTimeEntryRouter(timeEntryController)
+	tagRouter := routers.NewTagRouter(tagController)
+	boardRouter := routers.NewBoardRouter(boardController)
+	todoViewRouter := routers.NewTodoViewRouter(todoViewController)
+	todoTemplateRouter := routers.NewTodoTemplateRouter(todoTemplateController)
+	userSettingRouter := routers.NewUserSettingRouter(userSettingController)
+	searchRouter := routers.NewSearchRouter(searchController)
+	reminderRouter := routers.NewReminderRouter(reminderController)
+	notificationRouter := routers.NewNotificationRouter(notificationController)
+	attachmentRouter := routers.NewAttachmentRouter(attachmentController)
+
+	// job
+	trashPurgeJob := jobs.NewTrashPurgeJob(todoService, time.Duration(config.Config.TrashRetentionDays)*24*time.Hour)
+	trashPurgeJob.Start(time.Hour)
+	attachmentCleanupJob := jobs.NewAttachmentCleanupJob(attachmentService)
+	attachmentCleanupJob.Start(time.Hour)
+	autoArchiveJob := jobs.NewAutoArchiveJob(userSettingService)
+	autoArchiveJob.Start(time.Hour)
+	reminderJob := jobs.NewReminderJob(reminderService)
+	reminderJob.Start(time.Minute)
 
 	// router
 	r := gin.Default()
@@ -36,5 +114,20 @@ func main() {
 	r.GET("/", controllers.TopPage)
 	authRouter.SetRouting(r)
 	todoRouter.SetRouting(r)
+	checklistItemRouter.SetRouting(r)
+	todoBulkRouter.SetRouting(r)
+	todoShareLinkRouter.SetRouting(r)
+	commentRouter.SetRouting(r)
+	todoDependencyRouter.SetRouting(r)
+	timeEntryRouter.SetRouting(r)
+	tagRouter.SetRouting(r)
+	boardRouter.SetRouting(r)
+	todoViewRouter.SetRouting(r)
+	todoTemplateRouter.SetRouting(r)
+	userSettingRouter.SetRouting(r)
+	searchRouter.SetRouting(r)
+	reminderRouter.SetRouting(r)
+	notificationRouter.SetRouting(r)
+	attachmentRouter.SetRouting(r)
 	r.Run(":" + strconv.Itoa(config.Config.ServerPort))
 }
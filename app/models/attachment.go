@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// NOTE: ゴミ箱から戻せるよう、Todoの物理削除後に保存先のファイルとあわせて削除する(外部キー制約は設けない)
+type Attachment struct {
+	ID          int       `gorm:"primary_key" json:"id"`
+	TodoID      int       `gorm:"not null;index" json:"todo_id"`
+	UserID      int       `gorm:"not null" json:"user_id"`
+	FileName    string    `gorm:"size:255;not null" json:"file_name" validate:"required,max=255"`
+	ContentType string    `gorm:"size:100;not null" json:"content_type"`
+	Size        int64     `gorm:"not null" json:"size"`
+	StorageKey  string    `gorm:"size:255;not null;uniqueIndex" json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+const (
+	BoardGroupByStatus = "status"
+	BoardGroupByTag    = "tag"
+)
+
+// NOTE: 作成者のTodoをステータスまたはタグごとの列に分けて表示するボード
+type Board struct {
+	ID        int           `gorm:"primary_key" json:"id"`
+	UserID    int           `gorm:"not null;index" json:"user_id"`
+	User      User          `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	Name      string        `gorm:"size:255;not null" json:"name" validate:"required,max=255"`
+	GroupBy   string        `gorm:"size:20;not null" json:"group_by" validate:"required,oneof=status tag"`
+	Columns   []BoardColumn `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"columns" validate:"required,min=1,max=20,dive"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// NOTE: Valueはgroup_byに応じてステータスまたはタグ名を表す
+type BoardColumn struct {
+	ID       int    `gorm:"primary_key" json:"id"`
+	BoardID  int    `gorm:"not null;index" json:"board_id"`
+	Name     string `gorm:"size:255;not null" json:"name" validate:"required,max=255"`
+	Value    string `gorm:"size:50;not null" json:"value" validate:"required,max=50"`
+	Position int    `gorm:"not null;default:0" json:"position"`
+}
+
+// NOTE: Todoが該当する最初の列を返す(該当しない場合はnil)
+func (b *Board) ColumnFor(todo Todo) *BoardColumn {
+	for i, column := range b.Columns {
+		if b.GroupBy == BoardGroupByStatus && todo.Status == column.Value {
+			return &b.Columns[i]
+		}
+		if b.GroupBy == BoardGroupByTag && todo.HasTag(column.Value) {
+			return &b.Columns[i]
+		}
+	}
+	return nil
+}
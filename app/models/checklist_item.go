@@ -0,0 +1,24 @@
+package models
+
+type ChecklistItem struct {
+	ID       int    `gorm:"primary_key" json:"id"`
+	TodoID   int    `gorm:"not null;index" json:"todo_id"`
+	Title    string `gorm:"size:255;not null" json:"title" validate:"required"`
+	Done     bool   `gorm:"not null" json:"done"`
+	Position int    `gorm:"not null" json:"position"`
+}
+
+type ChecklistProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+func NewChecklistProgress(items []ChecklistItem) ChecklistProgress {
+	progress := ChecklistProgress{Total: len(items)}
+	for _, item := range items {
+		if item.Done {
+			progress.Done++
+		}
+	}
+	return progress
+}
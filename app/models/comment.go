@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type Comment struct {
+	ID        int       `gorm:"primary_key" json:"id"`
+	TodoID    int       `gorm:"not null;index" json:"todo_id"`
+	UserID    int       `gorm:"not null" json:"user_id"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	Body      string    `gorm:"type:text;not null" json:"body" validate:"required,max=10000"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+const (
+	NotificationTypeReminder  = "reminder"
+	NotificationTypeAssigned  = "assigned"
+	NotificationTypeShared    = "shared"
+	NotificationTypeCommented = "commented"
+)
+
+// NOTE: アプリ内の通知
+type Notification struct {
+	ID     int  `gorm:"primary_key" json:"id"`
+	UserID int  `gorm:"not null;index" json:"user_id"`
+	User   User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	// NOTE: 通知のきっかけとなった操作を行ったユーザ(リマインダーの場合はnil)
+	ActorID   *int       `gorm:"index" json:"actor_id"`
+	Actor     *User      `gorm:"foreignKey:ActorID;constraint:OnDelete:SET NULL" json:"-" validate:"omitempty"`
+	Type      string     `gorm:"size:50;not null" json:"type"`
+	TodoID    *int       `gorm:"index" json:"todo_id"`
+	Todo      *Todo      `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	Title     string     `gorm:"size:255;not null" json:"title"`
+	Body      string     `gorm:"type:text" json:"body"`
+	ReadAt    *time.Time `gorm:"index" json:"read_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+const (
+	ReminderChannelEmail   = "email"
+	ReminderChannelWebhook = "webhook"
+	ReminderChannelInApp   = "in_app"
+
+	// NOTE: 期日の何分前に通知するかの上限(30日)
+	ReminderMaxOffsetMinutes = 30 * 24 * 60
+	// NOTE: 配信に失敗した場合はこの回数まで再試行する
+	ReminderMaxAttempts = 5
+)
+
+// NOTE: Todoのリマインダー(日時を指定するか、期日の何分前かを指定する)
+type Reminder struct {
+	ID     int  `gorm:"primary_key" json:"id"`
+	TodoID int  `gorm:"not null;index" json:"todo_id"`
+	Todo   Todo `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	UserID int  `gorm:"not null;index" json:"user_id"`
+	User   User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	// NOTE: 期日の変更に追従するため、期日からの指定は通知日時に変換せずに保存する
+	RemindAt      *time.Time `gorm:"index" json:"remind_at" validate:"required_without=OffsetMinutes,excluded_with=OffsetMinutes"`
+	OffsetMinutes *int       `json:"offset_minutes" validate:"omitempty,min=0,max=43200"`
+	FireAt        *time.Time `gorm:"-" json:"fire_at"`
+	Channels      []string   `gorm:"type:text;serializer:json" json:"channels" validate:"min=1,max=3,unique,dive,oneof=email webhook in_app"`
+	// NOTE: 再試行時に配信済みのチャネルへ重複して送らないよう、チャネルごとに記録する
+	DeliveredChannels []string   `gorm:"type:text;serializer:json" json:"delivered_channels"`
+	Attempts          int        `gorm:"not null;default:0" json:"attempts"`
+	LastError         string     `gorm:"type:text" json:"last_error"`
+	ClaimedUntil      *time.Time `gorm:"index" json:"-"`
+	SentAt            *time.Time `gorm:"index" json:"sent_at"`
+	FailedAt          *time.Time `json:"failed_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// NOTE: 通知する日時(期日からの指定で期日が未設定の場合はnil)
+func (r Reminder) NextFireAt(dueAt *time.Time) *time.Time {
+	if r.RemindAt != nil {
+		return r.RemindAt
+	}
+	if r.OffsetMinutes == nil || dueAt == nil {
+		return nil
+	}
+	fireAt := dueAt.Add(-time.Duration(*r.OffsetMinutes) * time.Minute)
+	return &fireAt
+}
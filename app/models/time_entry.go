@@ -0,0 +1,127 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// NOTE: EndedAtが未設定の記録は計測中のタイマーを表す
+type TimeEntry struct {
+	ID              int        `gorm:"primary_key" json:"id"`
+	TodoID          int        `gorm:"not null;index" json:"todo_id"`
+	Todo            *Todo      `gorm:"foreignKey:TodoID" json:"-" validate:"omitempty"`
+	UserID          int        `gorm:"not null;index" json:"user_id"`
+	User            User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	StartedAt       time.Time  `gorm:"not null;index" json:"started_at" validate:"required"`
+	EndedAt         *time.Time `json:"ended_at"`
+	DurationSeconds int64      `gorm:"not null;default:0" json:"duration_seconds"`
+	Note            string     `gorm:"size:255" json:"note" validate:"max=255"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	// NOTE: 計測中の場合のみユーザIDとなる列で、一意制約により計測中のタイマーをユーザごとに1つに制限する
+	RunningUserID *int `gorm:"->;type:int GENERATED ALWAYS AS (CASE WHEN ended_at IS NULL THEN user_id END) STORED;uniqueIndex" json:"-"`
+}
+
+type TodoTimeReport struct {
+	TodoID  int    `json:"todo_id"`
+	Title   string `json:"title"`
+	Seconds int64  `json:"seconds"`
+}
+
+// NOTE: プロジェクトの概念が無いため、タグごとの集計をプロジェクトごとの集計として扱う
+type TagTimeReport struct {
+	Tag     string `json:"tag"`
+	Seconds int64  `json:"seconds"`
+}
+
+type DayTimeReport struct {
+	Date    string `json:"date"`
+	Seconds int64  `json:"seconds"`
+}
+
+type TimeReport struct {
+	From         string           `json:"from"`
+	To           string           `json:"to"`
+	TotalSeconds int64            `json:"total_seconds"`
+	Todos        []TodoTimeReport `json:"todos"`
+	Tags         []TagTimeReport  `json:"tags"`
+	Days         []DayTimeReport  `json:"days"`
+}
+
+// NOTE: from〜untilに含まれる時間のみを集計し、日を跨ぐ記録は日ごとに按分する
+// NOTE: 複数のタグが付いたTodoの時間はタグごとに計上し、タグの無いTodoの時間はタグごとの集計に含めない
+func NewTimeReport(entries []TimeEntry, from time.Time, until time.Time) TimeReport {
+	report := TimeReport{
+		From:  from.Format(time.DateOnly),
+		To:    until.AddDate(0, 0, -1).Format(time.DateOnly),
+		Todos: []TodoTimeReport{},
+		Tags:  []TagTimeReport{},
+		Days:  []DayTimeReport{},
+	}
+	todoIndexes := map[int]int{}
+	tagSeconds := map[string]int64{}
+	daySeconds := map[string]int64{}
+	for _, entry := range entries {
+		if entry.EndedAt == nil {
+			continue
+		}
+		start, end := entry.StartedAt.In(from.Location()), entry.EndedAt.In(from.Location())
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(until) {
+			end = until
+		}
+		if !end.After(start) {
+			continue
+		}
+
+		seconds := int64(end.Sub(start).Seconds())
+		report.TotalSeconds += seconds
+		if _, ok := todoIndexes[entry.TodoID]; !ok {
+			title := ""
+			if entry.Todo != nil {
+				title = entry.Todo.Title
+			}
+			todoIndexes[entry.TodoID] = len(report.Todos)
+			report.Todos = append(report.Todos, TodoTimeReport{TodoID: entry.TodoID, Title: title})
+		}
+		report.Todos[todoIndexes[entry.TodoID]].Seconds += seconds
+		if entry.Todo != nil {
+			for _, tag := range entry.Todo.Tags {
+				tagSeconds[tag] += seconds
+			}
+		}
+
+		for dayStart := start; dayStart.Before(end); {
+			nextDay := time.Date(dayStart.Year(), dayStart.Month(), dayStart.Day()+1, 0, 0, 0, 0, dayStart.Location())
+			dayEnd := end
+			if nextDay.Before(end) {
+				dayEnd = nextDay
+			}
+			daySeconds[dayStart.Format(time.DateOnly)] += int64(dayEnd.Sub(dayStart).Seconds())
+			dayStart = dayEnd
+		}
+	}
+
+	for tag, seconds := range tagSeconds {
+		report.Tags = append(report.Tags, TagTimeReport{Tag: tag, Seconds: seconds})
+	}
+	sort.Slice(report.Tags, func(i, j int) bool {
+		if report.Tags[i].Seconds != report.Tags[j].Seconds {
+			return report.Tags[i].Seconds > report.Tags[j].Seconds
+		}
+		return report.Tags[i].Tag < report.Tags[j].Tag
+	})
+	for date, seconds := range daySeconds {
+		report.Days = append(report.Days, DayTimeReport{Date: date, Seconds: seconds})
+	}
+	sort.Slice(report.Days, func(i, j int) bool { return report.Days[i].Date < report.Days[j].Date })
+	sort.Slice(report.Todos, func(i, j int) bool {
+		if report.Todos[i].Seconds != report.Todos[j].Seconds {
+			return report.Todos[i].Seconds > report.Todos[j].Seconds
+		}
+		return report.Todos[i].TodoID < report.Todos[j].TodoID
+	})
+	return report
+}
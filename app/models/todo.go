@@ -1,9 +1,86 @@
 package models
 
+import (
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	TodoStatusTodo = "todo"
+	TodoStatusDone = "done"
+
+	TodoPriorityLow    = "low"
+	TodoPriorityMedium = "medium"
+	TodoPriorityHigh   = "high"
+
+	// NOTE: 並び順がこの長さを超えた場合は振り直す
+	TodoPositionMaxLength = 50
+)
+
 type Todo struct {
-	ID      int    `gorm:"primary_key" json:"id"`
-	Title   string `gorm:"size:255;not null" validate:"required"`
-	Content string `gorm:"type:text"`
-	UserID  int    `gorm:"not null" json:"user_id"`
-	User    User   `gorm:"foreignKey:UserID" validate:"omitempty"`
+	ID                int               `gorm:"primary_key" json:"id"`
+	Title             string            `gorm:"size:255;not null" validate:"required"`
+	Content           string            `gorm:"type:text"`
+	ContentHTML       string            `gorm:"-" json:"content_html,omitempty"`
+	ContentText       string            `gorm:"-" json:"content_text,omitempty"`
+	Status            string            `gorm:"size:20;not null;default:todo" json:"status" validate:"omitempty,oneof=todo done"`
+	Priority          string            `gorm:"size:10;not null;default:'';index" json:"priority" validate:"omitempty,oneof=low medium high"`
+	CompletedAt       *time.Time        `json:"completed_at"`
+	ArchivedAt        *time.Time        `gorm:"index" json:"archived_at"`
+	DueAt             *time.Time        `json:"due_at" validate:"required_with=RecurrenceRule"`
+	RecurrenceRule    string            `gorm:"size:255" json:"recurrence_rule"`
+	RecurrenceStartAt *time.Time        `json:"recurrence_start_at"`
+	Position          string            `gorm:"size:255;not null;default:'';index" json:"position"`
+	UserID            int               `gorm:"not null" json:"user_id"`
+	User              User              `gorm:"foreignKey:UserID" validate:"omitempty"`
+	AssigneeID        *int              `gorm:"index" json:"assignee_id"`
+	Assignee          *User             `gorm:"foreignKey:AssigneeID;constraint:OnDelete:SET NULL" json:"-" validate:"omitempty"`
+	ChecklistItems    []ChecklistItem   `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"checklist_items" validate:"dive"`
+	ChecklistProgress ChecklistProgress `gorm:"-" json:"checklist_progress"`
+	Comments          []Comment         `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-"`
+	CommentCount      int               `gorm:"not null;default:0" json:"comment_count"`
+	Dependencies      []TodoDependency  `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-"`
+	Blocked           bool              `gorm:"-" json:"blocked"`
+	TimeEntries       []TimeEntry       `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-"`
+	TodoTags          []TodoTag         `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-"`
+	Tags              []string          `gorm:"-" json:"tags"`
+	Revisions         []TodoRevision    `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-"`
+	Shares            []TodoShare       `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-"`
+	ShareLinks        []TodoShareLink   `gorm:"foreignKey:TodoID;constraint:OnDelete:CASCADE" json:"-"`
+	DeletedAt         gorm.DeletedAt    `gorm:"index" json:"deleted_at"`
+	Version           int               `gorm:"not null;default:1" json:"version"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+// NOTE: 楽観的排他制御のためのバージョンは1から始める
+func (t *Todo) BeforeCreate(tx *gorm.DB) error {
+	if t.Version == 0 {
+		t.Version = 1
+	}
+	return nil
+}
+
+// NOTE: 取得時にチェックリストの進捗・タグ・未完了のブロッカーの有無を集計する
+func (t *Todo) AfterFind(tx *gorm.DB) error {
+	t.ChecklistProgress = NewChecklistProgress(t.ChecklistItems)
+	t.Tags = []string{}
+	for _, tag := range t.TodoTags {
+		t.Tags = append(t.Tags, tag.Name)
+	}
+	t.Blocked = false
+	for _, dependency := range t.Dependencies {
+		// NOTE: ゴミ箱のブロッカーは読み込まれないため対象外となる
+		if dependency.Blocker != nil && dependency.Blocker.Status != TodoStatusDone {
+			t.Blocked = true
+			break
+		}
+	}
+	return nil
+}
+
+func (t *Todo) HasTag(name string) bool {
+	return slices.Contains(t.Tags, name)
 }
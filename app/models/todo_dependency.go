@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// NOTE: TodoIDのTodoはBlockerIDのTodoが完了するまで着手できない
+type TodoDependency struct {
+	ID        int       `gorm:"primary_key" json:"id"`
+	TodoID    int       `gorm:"not null;uniqueIndex:idx_todo_dependencies_todo_id_blocker_id" json:"todo_id"`
+	BlockerID int       `gorm:"not null;uniqueIndex:idx_todo_dependencies_todo_id_blocker_id;index" json:"blocker_id"`
+	Blocker   *Todo     `gorm:"foreignKey:BlockerID;constraint:OnDelete:CASCADE" json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// NOTE: 一覧の絞り込み条件(保存したビューで日付がずれないよう、期限は実行日からの日数で指定する)
+type TodoFilter struct {
+	Status      string   `json:"status,omitempty" validate:"omitempty,oneof=todo done"`
+	Priorities  []string `json:"priorities,omitempty" validate:"omitempty,max=3,dive,oneof=low medium high"`
+	Tags        []string `json:"tags,omitempty" validate:"omitempty,max=20,dive,required,max=50"`
+	Overdue     bool     `json:"overdue,omitempty"`
+	DueFromDays *int     `json:"due_from_days,omitempty" validate:"omitempty,min=-366,max=366"`
+	DueToDays   *int     `json:"due_to_days,omitempty" validate:"omitempty,min=-366,max=366"`
+	// NOTE: 既定ではアーカイブしたTodoを除外する
+	IncludeArchived bool `json:"include_archived,omitempty"`
+}
+
+// NOTE: 期限による絞り込みは、Todoが更新されなくても実行時刻によって結果が変わる
+func (f TodoFilter) DependsOnNow() bool {
+	return f.Overdue || f.DueFromDays != nil || f.DueToDays != nil
+}
+
+// NOTE: 期限の範囲を[from, until)で返す(日数は実行日の0時を基準とし、期限切れは現在時刻までとする)
+func (f TodoFilter) DueRange(now time.Time) (*time.Time, *time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	var from, until *time.Time
+	if f.DueFromDays != nil {
+		dueFrom := today.AddDate(0, 0, *f.DueFromDays)
+		from = &dueFrom
+	}
+	if f.DueToDays != nil {
+		dueUntil := today.AddDate(0, 0, *f.DueToDays+1)
+		until = &dueUntil
+	}
+	if f.Overdue && (until == nil || now.Before(*until)) {
+		until = &now
+	}
+	return from, until
+}
@@ -0,0 +1,92 @@
+package models
+
+import "time"
+
+const (
+	TodoRevisionActionCreate   = "create"
+	TodoRevisionActionUpdate   = "update"
+	TodoRevisionActionComplete = "complete"
+	TodoRevisionActionReopen   = "reopen"
+	TodoRevisionActionRevert   = "revert"
+	TodoRevisionActionAssign   = "assign"
+)
+
+// NOTE: 履歴として記録するTodoの項目
+type TodoSnapshot struct {
+	Title          string     `json:"title"`
+	Content        string     `json:"content"`
+	Status         string     `json:"status"`
+	Priority       string     `json:"priority"`
+	DueAt          *time.Time `json:"due_at"`
+	RecurrenceRule string     `json:"recurrence_rule"`
+	AssigneeID     *int       `json:"assignee_id"`
+}
+
+type TodoFieldChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+type TodoRevision struct {
+	ID        int                        `gorm:"primary_key" json:"id"`
+	TodoID    int                        `gorm:"not null;uniqueIndex:idx_todo_revisions_todo_id_revision" json:"todo_id"`
+	Revision  int                        `gorm:"not null;uniqueIndex:idx_todo_revisions_todo_id_revision" json:"revision"`
+	UserID    int                        `gorm:"not null" json:"user_id"`
+	Action    string                     `gorm:"size:20;not null" json:"action"`
+	Changes   map[string]TodoFieldChange `gorm:"type:text;serializer:json" json:"changes"`
+	Snapshot  TodoSnapshot               `gorm:"type:text;serializer:json" json:"snapshot"`
+	CreatedAt time.Time                  `json:"created_at"`
+}
+
+func NewTodoSnapshot(todo Todo) TodoSnapshot {
+	return TodoSnapshot{
+		Title:          todo.Title,
+		Content:        todo.Content,
+		Status:         todo.Status,
+		Priority:       todo.Priority,
+		DueAt:          todo.DueAt,
+		RecurrenceRule: todo.RecurrenceRule,
+		AssigneeID:     todo.AssigneeID,
+	}
+}
+
+// NOTE: 変更のあった項目のみ変更前後の値を返す
+func (before TodoSnapshot) Diff(after TodoSnapshot) map[string]TodoFieldChange {
+	changes := map[string]TodoFieldChange{}
+	if before.Title != after.Title {
+		changes["title"] = TodoFieldChange{Old: before.Title, New: after.Title}
+	}
+	if before.Content != after.Content {
+		changes["content"] = TodoFieldChange{Old: before.Content, New: after.Content}
+	}
+	if before.Status != after.Status {
+		changes["status"] = TodoFieldChange{Old: before.Status, New: after.Status}
+	}
+	if before.Priority != after.Priority {
+		changes["priority"] = TodoFieldChange{Old: before.Priority, New: after.Priority}
+	}
+	if !sameTime(before.DueAt, after.DueAt) {
+		changes["due_at"] = TodoFieldChange{Old: before.DueAt, New: after.DueAt}
+	}
+	if before.RecurrenceRule != after.RecurrenceRule {
+		changes["recurrence_rule"] = TodoFieldChange{Old: before.RecurrenceRule, New: after.RecurrenceRule}
+	}
+	if !sameInt(before.AssigneeID, after.AssigneeID) {
+		changes["assignee_id"] = TodoFieldChange{Old: before.AssigneeID, New: after.AssigneeID}
+	}
+	return changes
+}
+
+func sameTime(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+func sameInt(a *int, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
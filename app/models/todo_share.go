@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+const (
+	TodoShareRoleViewer = "viewer"
+	TodoShareRoleEditor = "editor"
+	TodoShareRoleOwner  = "owner"
+)
+
+// NOTE: Todoを共同作業者に共有する際の権限
+type TodoShare struct {
+	ID        int       `gorm:"primary_key" json:"id"`
+	TodoID    int       `gorm:"not null;uniqueIndex:idx_todo_shares_todo_id_user_id" json:"todo_id"`
+	UserID    int       `gorm:"not null;uniqueIndex:idx_todo_shares_todo_id_user_id;index" json:"user_id"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	Role      string    `gorm:"size:20;not null" json:"role" validate:"required,oneof=viewer editor owner"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
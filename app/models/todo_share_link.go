@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// NOTE: アカウントを持たない相手にTodoを閲覧専用で公開するためのリンク
+type TodoShareLink struct {
+	ID                int        `gorm:"primary_key" json:"id"`
+	TodoID            int        `gorm:"not null;index" json:"todo_id"`
+	Token             string     `gorm:"size:64;not null;uniqueIndex" json:"token"`
+	PasswordHash      string     `gorm:"size:255;not null;default:''" json:"-"`
+	PasswordProtected bool       `gorm:"-" json:"password_protected"`
+	ExpiresAt         *time.Time `json:"expires_at"`
+	UserID            int        `gorm:"not null" json:"user_id"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// NOTE: 取得時にパスワードの有無を設定する
+func (l *TodoShareLink) AfterFind(tx *gorm.DB) error {
+	l.PasswordProtected = l.PasswordHash != ""
+	return nil
+}
+
+func (l TodoShareLink) IsExpired(now time.Time) bool {
+	return l.ExpiresAt != nil && !now.Before(*l.ExpiresAt)
+}
@@ -0,0 +1,20 @@
+package models
+
+import "strings"
+
+const (
+	TodoTagMaxLength = 50
+	TodoTagMaxCount  = 20
+)
+
+// NOTE: タグはTodoごとの名前のみで管理し、一覧は作成者のTodoから集める
+type TodoTag struct {
+	ID     int    `gorm:"primary_key" json:"id"`
+	TodoID int    `gorm:"not null;uniqueIndex:idx_todo_tags_todo_id_name" json:"todo_id"`
+	Name   string `gorm:"size:50;not null;uniqueIndex:idx_todo_tags_todo_id_name;index" json:"name"`
+}
+
+// NOTE: 先頭の#と前後の空白を除き、小文字に揃える
+func NormalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(name), "#")))
+}
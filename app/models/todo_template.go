@@ -0,0 +1,70 @@
+package models
+
+import (
+	"regexp"
+	"slices"
+	"time"
+)
+
+// NOTE: {{変数名}}の形式で埋め込まれた変数(前後の空白は無視する)
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+
+// NOTE: Todoの雛形(タイトル・内容・チェックリストの変数はTodoの作成時に置き換える)
+type TodoTemplate struct {
+	ID             int       `gorm:"primary_key" json:"id"`
+	UserID         int       `gorm:"not null;index" json:"user_id"`
+	User           User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	Name           string    `gorm:"size:255;not null" json:"name" validate:"required,max=255"`
+	Title          string    `gorm:"size:255;not null" json:"title" validate:"required,max=255"`
+	Content        string    `gorm:"type:text" json:"content"`
+	Priority       string    `gorm:"size:10;not null;default:''" json:"priority" validate:"omitempty,oneof=low medium high"`
+	ChecklistItems []string  `gorm:"type:text;serializer:json" json:"checklist_items" validate:"max=100,dive,required,max=255"`
+	Tags           []string  `gorm:"type:text;serializer:json" json:"tags"`
+	DueOffsetDays  *int      `json:"due_offset_days" validate:"omitempty,min=0,max=3650"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// NOTE: 期日は作成日から期日までの日数として保存する
+func NewTodoTemplate(todo Todo) TodoTemplate {
+	template := TodoTemplate{
+		Title:          todo.Title,
+		Content:        todo.Content,
+		Priority:       todo.Priority,
+		ChecklistItems: []string{},
+		Tags:           slices.Clone(todo.Tags),
+	}
+	for _, item := range todo.ChecklistItems {
+		template.ChecklistItems = append(template.ChecklistItems, item.Title)
+	}
+	if todo.DueAt != nil {
+		createdOn := time.Date(todo.CreatedAt.Year(), todo.CreatedAt.Month(), todo.CreatedAt.Day(), 0, 0, 0, 0, time.UTC)
+		dueOn := time.Date(todo.DueAt.Year(), todo.DueAt.Month(), todo.DueAt.Day(), 0, 0, 0, 0, time.UTC)
+		dueOffsetDays := max(int(dueOn.Sub(createdOn).Hours()/24), 0)
+		template.DueOffsetDays = &dueOffsetDays
+	}
+	return template
+}
+
+// NOTE: タイトル・内容・チェックリストに含まれる変数名を出現順に重複なく返す
+func (t *TodoTemplate) Variables() []string {
+	variables := []string{}
+	for _, text := range append([]string{t.Title, t.Content}, t.ChecklistItems...) {
+		for _, match := range templateVariablePattern.FindAllStringSubmatch(text, -1) {
+			if !slices.Contains(variables, match[1]) {
+				variables = append(variables, match[1])
+			}
+		}
+	}
+	return variables
+}
+
+// NOTE: 変数を値に置き換える(値が指定されていない変数はそのまま残す)
+func ExpandTemplateVariables(text string, values map[string]string) string {
+	return templateVariablePattern.ReplaceAllStringFunc(text, func(match string) string {
+		if value, ok := values[templateVariablePattern.FindStringSubmatch(match)[1]]; ok {
+			return value
+		}
+		return match
+	})
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+const (
+	SmartListToday    = "today"
+	SmartListOverdue  = "overdue"
+	SmartListUpcoming = "upcoming"
+
+	// NOTE: 「今後の予定」は翌日から7日後までとする
+	SmartListUpcomingDays = 7
+)
+
+// NOTE: 名前を付けて保存した一覧の絞り込み条件
+type TodoView struct {
+	ID        int        `gorm:"primary_key" json:"id"`
+	UserID    int        `gorm:"not null;index" json:"user_id"`
+	User      User       `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	Name      string     `gorm:"size:255;not null" json:"name" validate:"required,max=255"`
+	Filter    TodoFilter `gorm:"type:text;serializer:json" json:"filter"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// NOTE: 全ユーザに組み込みで用意する一覧
+type SmartList struct {
+	Key    string     `json:"key"`
+	Name   string     `json:"name"`
+	Filter TodoFilter `json:"filter"`
+}
+
+func SmartLists() []SmartList {
+	zero := 0
+	one := 1
+	upcomingDays := SmartListUpcomingDays
+	return []SmartList{
+		{Key: SmartListToday, Name: "Today", Filter: TodoFilter{Status: TodoStatusTodo, DueFromDays: &zero, DueToDays: &zero}},
+		{Key: SmartListOverdue, Name: "Overdue", Filter: TodoFilter{Status: TodoStatusTodo, Overdue: true}},
+		{Key: SmartListUpcoming, Name: "Upcoming", Filter: TodoFilter{Status: TodoStatusTodo, DueFromDays: &one, DueToDays: &upcomingDays}},
+	}
+}
+
+func FindSmartList(key string) (SmartList, bool) {
+	for _, smartList := range SmartLists() {
+		if smartList.Key == key {
+			return smartList, true
+		}
+	}
+	return SmartList{}, false
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// NOTE: ユーザごとの設定(未作成のユーザは既定値として扱う)
+type UserSetting struct {
+	ID     int  `gorm:"primary_key" json:"id"`
+	UserID int  `gorm:"not null;uniqueIndex" json:"user_id"`
+	User   User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE" json:"-" validate:"omitempty"`
+	// NOTE: 完了から指定日数が経過したTodoを自動でアーカイブする(nilの場合は自動アーカイブしない)
+	AutoArchiveDays *int `json:"auto_archive_days" validate:"omitempty,min=1,max=365"`
+	// NOTE: リマインダーをwebhookで受け取るURL(httpsのみ)
+	WebhookURL string `gorm:"size:2048;not null;default:''" json:"webhook_url" validate:"omitempty,http_url,startswith=https://,max=2048"`
+	// NOTE: アプリ内通知を受け取らないイベントの種類
+	MutedNotificationTypes []string  `gorm:"type:text;serializer:json" json:"muted_notification_types" validate:"max=3,unique,dive,oneof=assigned shared commented"`
+	CreatedAt              time.Time `json:"created_at"`
+	UpdatedAt              time.Time `json:"updated_at"`
+}
+
+func (s UserSetting) NotificationEnabled(notificationType string) bool {
+	return !slices.Contains(s.MutedNotificationTypes, notificationType)
+}
@@ -0,0 +1,54 @@
+package notifier
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+)
+
+type EmailConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type emailNotifier struct {
+	config   EmailConfig
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewEmailNotifier(config EmailConfig) Notifier {
+	return &emailNotifier{config, smtp.SendMail}
+}
+
+func (en *emailNotifier) Notify(message Message) error {
+	if en.config.Host == "" || en.config.From == "" || message.Email == "" {
+		return fmt.Errorf("email: %w", ErrChannelNotConfigured)
+	}
+
+	// NOTE: 認証情報が未設定の場合は認証せずに送信する
+	var auth smtp.Auth
+	if en.config.Username != "" {
+		auth = smtp.PlainAuth("", en.config.Username, en.config.Password, en.config.Host)
+	}
+	addr := net.JoinHostPort(en.config.Host, strconv.Itoa(en.config.Port))
+	return en.sendMail(addr, auth, en.config.From, []string{message.Email}, en.buildMessage(message))
+}
+
+// NOTE: 件名は日本語を含むためMIMEエンコードする
+func (en *emailNotifier) buildMessage(message Message) []byte {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "From: %s\r\n", en.config.From)
+	fmt.Fprintf(&buffer, "To: %s\r\n", message.Email)
+	fmt.Fprintf(&buffer, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", message.Subject))
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buffer.WriteString("\r\n")
+	buffer.WriteString(message.Body)
+	return buffer.Bytes()
+}
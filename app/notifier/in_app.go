@@ -0,0 +1,22 @@
+package notifier
+
+import (
+	"app/models"
+	"app/repositories"
+)
+
+type inAppNotifier struct {
+	notificationRepository repositories.NotificationRepository
+}
+
+func NewInAppNotifier(notificationRepository repositories.NotificationRepository) Notifier {
+	return &inAppNotifier{notificationRepository}
+}
+
+func (in *inAppNotifier) Notify(message Message) error {
+	notification := models.Notification{UserID: message.UserID, Type: message.Type, Title: message.Subject, Body: message.Body}
+	if message.TodoID != 0 {
+		notification.TodoID = &message.TodoID
+	}
+	return in.notificationRepository.CreateNotification(&notification)
+}
@@ -0,0 +1,43 @@
+package notifier
+
+import (
+	"app/config"
+	"app/models"
+	"app/repositories"
+	"errors"
+	"time"
+)
+
+// NOTE: 通知先が設定されていないチャネルに送ろうとした場合のエラー
+var ErrChannelNotConfigured = errors.New("notification channel is not configured")
+
+// NOTE: 通知の内容と宛先(宛先は利用するチャネルのもののみ参照する)
+type Message struct {
+	Type       string
+	UserID     int
+	Email      string
+	WebhookURL string
+	TodoID     int
+	Subject    string
+	Body       string
+}
+
+// NOTE: 通知の配信方法(メール・webhook・アプリ内通知を切り替えられる)
+type Notifier interface {
+	Notify(message Message) error
+}
+
+// NOTE: チャネル名ごとの配信方法を返す
+func Init(notificationRepository repositories.NotificationRepository) map[string]Notifier {
+	return map[string]Notifier{
+		models.ReminderChannelEmail: NewEmailNotifier(EmailConfig{
+			Host:     config.Config.SMTPHost,
+			Port:     config.Config.SMTPPort,
+			Username: config.Config.SMTPUsername,
+			Password: config.Config.SMTPPassword,
+			From:     config.Config.SMTPFrom,
+		}),
+		models.ReminderChannelWebhook: NewWebhookNotifier(NewWebhookClient(10 * time.Second)),
+		models.ReminderChannelInApp:   NewInAppNotifier(notificationRepository),
+	}
+}
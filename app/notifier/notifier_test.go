@@ -0,0 +1,132 @@
+package notifier
+
+import (
+	"app/models"
+	"app/repositories"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MockNotificationRepository struct {
+	repositories.NotificationRepository
+	mock.Mock
+}
+
+func (_m *MockNotificationRepository) CreateNotification(notification *models.Notification) error {
+	ret := _m.Called(notification)
+	return ret.Error(0)
+}
+
+type TestNotifierSuite struct {
+	suite.Suite
+}
+
+var testMessage = Message{Type: models.NotificationTypeReminder, UserID: 1, Email: "test@example.com", WebhookURL: "", TodoID: 2, Subject: "Reminder: 会議", Body: "\"会議\" is due"}
+
+func (s *TestNotifierSuite) TestEmailNotifier() {
+	var sentAddr string
+	var sentMessage []byte
+	en := &emailNotifier{EmailConfig{Host: "smtp.example.com", Port: 587, From: "noreply@example.com"}, func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sentAddr = addr
+		sentMessage = msg
+		return nil
+	}}
+
+	err := en.Notify(testMessage)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "smtp.example.com:587", sentAddr)
+	assert.Contains(s.T(), string(sentMessage), "To: test@example.com\r\n")
+	assert.Contains(s.T(), string(sentMessage), "Subject: =?UTF-8?b?")
+	assert.True(s.T(), strings.HasSuffix(string(sentMessage), "\r\n\r\n\"会議\" is due"))
+}
+
+func (s *TestNotifierSuite) TestEmailNotifier_NotConfigured() {
+	err := NewEmailNotifier(EmailConfig{}).Notify(testMessage)
+
+	assert.ErrorIs(s.T(), err, ErrChannelNotConfigured)
+}
+
+func (s *TestNotifierSuite) TestWebhookNotifier() {
+	payload := webhookPayload{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &payload)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	message := testMessage
+	message.WebhookURL = server.URL
+
+	err := NewWebhookNotifier(server.Client()).Notify(message)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), webhookPayload{Type: models.NotificationTypeReminder, TodoID: 2, Subject: "Reminder: 会議", Body: "\"会議\" is due"}, payload)
+}
+
+func (s *TestNotifierSuite) TestWebhookNotifier_ErrorStatus() {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	message := testMessage
+	message.WebhookURL = server.URL
+
+	err := NewWebhookNotifier(server.Client()).Notify(message)
+
+	assert.NotNil(s.T(), err)
+	// NOTE: URLが未設定の場合は送信しないこと
+	assert.ErrorIs(s.T(), NewWebhookNotifier(server.Client()).Notify(testMessage), ErrChannelNotConfigured)
+}
+
+func (s *TestNotifierSuite) TestWebhookNotifier_NotAllowed() {
+	called := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	message := testMessage
+	message.WebhookURL = server.URL
+	webhookNotifier := NewWebhookNotifier(NewWebhookClient(time.Second))
+
+	// NOTE: ループバック宛ての接続は拒否されること
+	assert.ErrorIs(s.T(), webhookNotifier.Notify(message), errWebhookAddressNotAllowed)
+	assert.False(s.T(), called)
+	// NOTE: httpsでないURLには送信しないこと
+	message.WebhookURL = "http://example.com/hooks/reminder"
+	assert.ErrorIs(s.T(), webhookNotifier.Notify(message), ErrWebhookURLNotAllowed)
+}
+
+func (s *TestNotifierSuite) TestDenyInternalAddress() {
+	for _, address := range []string{"127.0.0.1:443", "[::1]:443", "10.0.0.1:443", "192.168.1.1:443", "169.254.169.254:80", "[::ffff:127.0.0.1]:443", "0.0.0.0:443"} {
+		assert.ErrorIs(s.T(), denyInternalAddress("tcp", address, nil), errWebhookAddressNotAllowed, address)
+	}
+	assert.Nil(s.T(), denyInternalAddress("tcp", "93.184.216.34:443", nil))
+}
+
+func (s *TestNotifierSuite) TestInAppNotifier() {
+	mockNotificationRepository := new(MockNotificationRepository)
+	mockNotificationRepository.On("CreateNotification", mock.MatchedBy(func(notification *models.Notification) bool {
+		return notification.UserID == 1 && *notification.TodoID == 2 && notification.Title == "Reminder: 会議"
+	})).Return(nil).Once()
+	mockNotificationRepository.On("CreateNotification", mock.Anything).Return(errors.New("db error"))
+
+	assert.Nil(s.T(), NewInAppNotifier(mockNotificationRepository).Notify(testMessage))
+	assert.NotNil(s.T(), NewInAppNotifier(mockNotificationRepository).Notify(testMessage))
+}
+
+func TestNotifier(t *testing.T) {
+	suite.Run(t, new(TestNotifierSuite))
+}
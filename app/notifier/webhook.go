@@ -0,0 +1,92 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// NOTE: httpsでないURLへ送ろうとした場合のエラー
+var ErrWebhookURLNotAllowed = errors.New("webhook url must use https")
+
+// NOTE: 内部ネットワーク宛ての接続を拒否した場合のエラー
+var errWebhookAddressNotAllowed = errors.New("webhook address is not allowed")
+
+type webhookPayload struct {
+	Type    string `json:"type"`
+	TodoID  int    `json:"todo_id"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+type webhookNotifier struct {
+	client *http.Client
+}
+
+func NewWebhookNotifier(client *http.Client) Notifier {
+	return &webhookNotifier{client}
+}
+
+// NOTE: 利用者が指定したURLへ送信するため、ループバック・プライベート・リンクローカル宛ての接続を拒否するクライアントを作成する
+// NOTE: 名前解決の結果が変わっても回避されないよう、接続時のアドレスで判定する(プロキシ・リダイレクトは経由しない)
+func NewWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: denyInternalAddress}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// NOTE: 2xx以外の応答は配信の失敗として扱う
+func (wn *webhookNotifier) Notify(message Message) error {
+	if message.WebhookURL == "" {
+		return fmt.Errorf("webhook: %w", ErrChannelNotConfigured)
+	}
+	webhookURL, err := url.Parse(message.WebhookURL)
+	if err != nil || webhookURL.Scheme != "https" || webhookURL.Host == "" {
+		return ErrWebhookURLNotAllowed
+	}
+
+	body, err := json.Marshal(webhookPayload{Type: message.Type, TodoID: message.TodoID, Subject: message.Subject, Body: message.Body})
+	if err != nil {
+		return err
+	}
+	res, err := wn.client.Post(webhookURL.String(), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook: unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
+
+func denyInternalAddress(network string, address string, conn syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return errWebhookAddressNotAllowed
+	}
+	addr := addrPort.Addr().Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || sharedAddressSpace.Contains(addr) {
+		return errWebhookAddressNotAllowed
+	}
+	return nil
+}
+
+// NOTE: キャリアグレードNAT用の共有アドレス(RFC 6598)
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type AttachmentRepository interface {
+	CreateAttachment(attachment *models.Attachment) error
+	GetAttachments(attachments *[]models.Attachment, todoId int) error
+	GetAttachmentById(attachment *models.Attachment, id int, todoId int) error
+	GetOrphanedAttachments(attachments *[]models.Attachment) error
+	DeleteAttachment(attachment *models.Attachment) error
+}
+
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	return &attachmentRepository{db}
+}
+
+func (ar *attachmentRepository) CreateAttachment(attachment *models.Attachment) error {
+	if err := ar.db.Create(&attachment).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ar *attachmentRepository) GetAttachments(attachments *[]models.Attachment, todoId int) error {
+	if err := ar.db.Where("todo_id = ?", todoId).Order("id ASC").Find(&attachments).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ar *attachmentRepository) GetAttachmentById(attachment *models.Attachment, id int, todoId int) error {
+	if err := ar.db.Where("todo_id = ?", todoId).First(&attachment, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 物理削除されたTodoの添付ファイル(ゴミ箱のTodoの添付ファイルは含まない)
+func (ar *attachmentRepository) GetOrphanedAttachments(attachments *[]models.Attachment) error {
+	err := ar.db.Joins("LEFT JOIN todos ON todos.id = attachments.todo_id").
+		Where("todos.id IS NULL").
+		Order("attachments.id ASC").
+		Find(&attachments).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ar *attachmentRepository) DeleteAttachment(attachment *models.Attachment) error {
+	if err := ar.db.Delete(&attachment).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestAttachmentRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestAttachmentRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestAttachmentRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestAttachmentRePositorySuite) TestCreateAttachment() {
+	ar := NewAttachmentRepository(DbCon)
+	attachment := models.Attachment{TodoID: todo.ID, UserID: user.ID, FileName: "test.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/1/key1"}
+	err := ar.CreateAttachment(&attachment)
+
+	assert.Nil(s.T(), err)
+	assert.NotEqual(s.T(), 0, attachment.ID)
+}
+
+func (s *TestAttachmentRePositorySuite) TestGetAttachments() {
+	attachments := []models.Attachment{
+		{TodoID: todo.ID, UserID: user.ID, FileName: "test1.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/1/key1"},
+		{TodoID: todo.ID, UserID: user.ID, FileName: "test2.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/1/key2"},
+	}
+	if err := DbCon.Create(&attachments).Error; err != nil {
+		s.T().Fatalf("failed to create test attachments %v", err)
+	}
+
+	ar := NewAttachmentRepository(DbCon)
+	fetchedAttachments := []models.Attachment{}
+	err := ar.GetAttachments(&fetchedAttachments, todo.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), fetchedAttachments, 2)
+	assert.Equal(s.T(), "test1.txt", fetchedAttachments[0].FileName)
+}
+
+func (s *TestAttachmentRePositorySuite) TestGetAttachmentById_OtherTodo() {
+	otherTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID}
+	if err := DbCon.Create(&otherTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	attachment := models.Attachment{TodoID: otherTodo.ID, UserID: user.ID, FileName: "test.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/2/key1"}
+	if err := DbCon.Create(&attachment).Error; err != nil {
+		s.T().Fatalf("failed to create test attachment %v", err)
+	}
+
+	ar := NewAttachmentRepository(DbCon)
+	fetchedAttachment := models.Attachment{}
+	err := ar.GetAttachmentById(&fetchedAttachment, attachment.ID, todo.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestAttachmentRePositorySuite) TestGetOrphanedAttachments() {
+	trashedTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID}
+	purgedTodo := models.Todo{Title: "test title 3", Content: "test content 3", UserID: user.ID}
+	for _, t := range []*models.Todo{&trashedTodo, &purgedTodo} {
+		if err := DbCon.Create(t).Error; err != nil {
+			s.T().Fatalf("failed to create test todo %v", err)
+		}
+	}
+	attachments := []models.Attachment{
+		{TodoID: todo.ID, UserID: user.ID, FileName: "test1.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/1/key1"},
+		{TodoID: trashedTodo.ID, UserID: user.ID, FileName: "test2.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/2/key1"},
+		{TodoID: purgedTodo.ID, UserID: user.ID, FileName: "test3.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/3/key1"},
+	}
+	if err := DbCon.Create(&attachments).Error; err != nil {
+		s.T().Fatalf("failed to create test attachments %v", err)
+	}
+	DbCon.Delete(&trashedTodo)
+	DbCon.Unscoped().Delete(&purgedTodo)
+
+	ar := NewAttachmentRepository(DbCon)
+	orphanedAttachments := []models.Attachment{}
+	err := ar.GetOrphanedAttachments(&orphanedAttachments)
+
+	// NOTE: ゴミ箱のTodoの添付ファイルは対象外であること
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), orphanedAttachments, 1)
+	assert.Equal(s.T(), "test3.txt", orphanedAttachments[0].FileName)
+}
+
+func (s *TestAttachmentRePositorySuite) TestDeleteAttachment() {
+	attachment := models.Attachment{TodoID: todo.ID, UserID: user.ID, FileName: "test.txt", ContentType: "text/plain", Size: 4, StorageKey: "todos/1/key1"}
+	if err := DbCon.Create(&attachment).Error; err != nil {
+		s.T().Fatalf("failed to create test attachment %v", err)
+	}
+
+	ar := NewAttachmentRepository(DbCon)
+	err := ar.DeleteAttachment(&attachment)
+
+	assert.Nil(s.T(), err)
+	var count int64
+	DbCon.Model(&models.Attachment{}).Count(&count)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func TestAttachmentRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestAttachmentRePositorySuite))
+}
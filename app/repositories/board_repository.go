@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type BoardRepository interface {
+	CreateBoard(board *models.Board) error
+	GetBoards(boards *[]models.Board, userId int) error
+	GetBoardById(board *models.Board, id int, userId int) error
+	UpdateBoard(board *models.Board) error
+	DeleteBoard(board *models.Board) error
+}
+
+type boardRepository struct {
+	db *gorm.DB
+}
+
+func NewBoardRepository(db *gorm.DB) BoardRepository {
+	return &boardRepository{db}
+}
+
+func (br *boardRepository) CreateBoard(board *models.Board) error {
+	if err := br.db.Create(&board).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (br *boardRepository) GetBoards(boards *[]models.Board, userId int) error {
+	if err := br.db.Preload("Columns", br.orderColumns).Where("user_id = ?", userId).Order("id ASC").Find(&boards).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (br *boardRepository) GetBoardById(board *models.Board, id int, userId int) error {
+	if err := br.db.Preload("Columns", br.orderColumns).Where("user_id = ?", userId).First(&board, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 列は全て作り直す
+func (br *boardRepository) UpdateBoard(board *models.Board) error {
+	return br.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&board).Updates(map[string]interface{}{"name": board.Name, "group_by": board.GroupBy}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("board_id = ?", board.ID).Delete(&models.BoardColumn{}).Error; err != nil {
+			return err
+		}
+		for i := range board.Columns {
+			board.Columns[i].ID = 0
+			board.Columns[i].BoardID = board.ID
+		}
+		return tx.Create(&board.Columns).Error
+	})
+}
+
+func (br *boardRepository) DeleteBoard(board *models.Board) error {
+	if err := br.db.Delete(&board).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (br *boardRepository) orderColumns(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestBoardRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestBoardRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+}
+
+func (s *TestBoardRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestBoardRePositorySuite) TestCreateBoard() {
+	br := NewBoardRepository(DbCon)
+	board := models.Board{UserID: user.ID, Name: "test board", GroupBy: models.BoardGroupByStatus, Columns: []models.BoardColumn{
+		{Name: "Todo", Value: models.TodoStatusTodo, Position: 0},
+		{Name: "Done", Value: models.TodoStatusDone, Position: 1},
+	}}
+	err := br.CreateBoard(&board)
+
+	assert.Nil(s.T(), err)
+	fetchedBoard := models.Board{}
+	assert.Nil(s.T(), br.GetBoardById(&fetchedBoard, board.ID, user.ID))
+	assert.Len(s.T(), fetchedBoard.Columns, 2)
+	assert.Equal(s.T(), "Todo", fetchedBoard.Columns[0].Name)
+}
+
+func (s *TestBoardRePositorySuite) TestGetBoardById_OtherUser() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	board := models.Board{UserID: otherUser.ID, Name: "test board", GroupBy: models.BoardGroupByTag, Columns: []models.BoardColumn{{Name: "Home", Value: "home"}}}
+	if err := DbCon.Create(&board).Error; err != nil {
+		s.T().Fatalf("failed to create test board %v", err)
+	}
+
+	br := NewBoardRepository(DbCon)
+	fetchedBoard := models.Board{}
+	err := br.GetBoardById(&fetchedBoard, board.ID, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestBoardRePositorySuite) TestUpdateBoard() {
+	board := models.Board{UserID: user.ID, Name: "test board", GroupBy: models.BoardGroupByTag, Columns: []models.BoardColumn{{Name: "Home", Value: "home"}}}
+	if err := DbCon.Create(&board).Error; err != nil {
+		s.T().Fatalf("failed to create test board %v", err)
+	}
+
+	br := NewBoardRepository(DbCon)
+	board.Name = "test updated board"
+	board.Columns = []models.BoardColumn{{Name: "Work", Value: "work", Position: 0}, {Name: "Home", Value: "home", Position: 1}}
+	err := br.UpdateBoard(&board)
+
+	assert.Nil(s.T(), err)
+	fetchedBoard := models.Board{}
+	br.GetBoardById(&fetchedBoard, board.ID, user.ID)
+	assert.Equal(s.T(), "test updated board", fetchedBoard.Name)
+	assert.Len(s.T(), fetchedBoard.Columns, 2)
+	assert.Equal(s.T(), "work", fetchedBoard.Columns[0].Value)
+}
+
+func TestBoardRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestBoardRePositorySuite))
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type ChecklistItemRepository interface {
+	CreateChecklistItem(item *models.ChecklistItem) error
+	GetChecklistItems(items *[]models.ChecklistItem, todoId int) error
+	GetChecklistItemById(item *models.ChecklistItem, id int, todoId int) error
+	UpdateChecklistItem(item *models.ChecklistItem) error
+	DeleteChecklistItem(item *models.ChecklistItem) error
+	ReorderChecklistItems(todoId int, itemIds []int) error
+}
+
+type checklistItemRepository struct {
+	db *gorm.DB
+}
+
+func NewChecklistItemRepository(db *gorm.DB) ChecklistItemRepository {
+	return &checklistItemRepository{db}
+}
+
+func (cr *checklistItemRepository) CreateChecklistItem(item *models.ChecklistItem) error {
+	// NOTE: 末尾に追加する
+	var maxPosition int
+	err := cr.db.Model(&models.ChecklistItem{}).Where("todo_id = ?", item.TodoID).Select("COALESCE(MAX(position), 0)").Scan(&maxPosition).Error
+	if err != nil {
+		return err
+	}
+	item.Position = maxPosition + 1
+
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&item).Error; err != nil {
+			return err
+		}
+		return cr.touchTodo(tx, item.TodoID)
+	})
+}
+
+func (cr *checklistItemRepository) GetChecklistItems(items *[]models.ChecklistItem, todoId int) error {
+	if err := cr.db.Where("todo_id = ?", todoId).Order("position ASC").Find(&items).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *checklistItemRepository) GetChecklistItemById(item *models.ChecklistItem, id int, todoId int) error {
+	if err := cr.db.Where("todo_id = ?", todoId).First(&item, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *checklistItemRepository) UpdateChecklistItem(item *models.ChecklistItem) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&item).Updates(map[string]interface{}{
+			"title": item.Title,
+			"done":  item.Done,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return cr.touchTodo(tx, item.TodoID)
+	})
+}
+
+func (cr *checklistItemRepository) DeleteChecklistItem(item *models.ChecklistItem) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&item).Error; err != nil {
+			return err
+		}
+		return cr.touchTodo(tx, item.TodoID)
+	})
+}
+
+func (cr *checklistItemRepository) ReorderChecklistItems(todoId int, itemIds []int) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range itemIds {
+			err := tx.Model(&models.ChecklistItem{}).Where("id = ? AND todo_id = ?", id, todoId).Update("position", i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+		return cr.touchTodo(tx, todoId)
+	})
+}
+
+// NOTE: チェックリストの変更もTodoの変更として扱い、親のTodoのバージョンと更新日時を進める
+func (cr *checklistItemRepository) touchTodo(tx *gorm.DB, todoId int) error {
+	return tx.Model(&models.Todo{}).Where("id = ?", todoId).Update("version", gorm.Expr("version + 1")).Error
+}
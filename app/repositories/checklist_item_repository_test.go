@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var todo models.Todo
+
+type TestChecklistItemRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestChecklistItemRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestChecklistItemRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestChecklistItemRePositorySuite) TestCreateChecklistItem() {
+	cr := NewChecklistItemRepository(DbCon)
+	firstItem := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1"}
+	secondItem := models.ChecklistItem{TodoID: todo.ID, Title: "test item 2"}
+	firstErr := cr.CreateChecklistItem(&firstItem)
+	secondErr := cr.CreateChecklistItem(&secondItem)
+
+	assert.Nil(s.T(), firstErr)
+	assert.Nil(s.T(), secondErr)
+	assert.NotEqual(s.T(), 0, firstItem.ID)
+	// NOTE: 末尾に追加されていること
+	assert.Equal(s.T(), 1, firstItem.Position)
+	assert.Equal(s.T(), 2, secondItem.Position)
+}
+
+func (s *TestChecklistItemRePositorySuite) TestGetChecklistItemById() {
+	insertItem := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&insertItem).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	item := models.ChecklistItem{}
+	cr := NewChecklistItemRepository(DbCon)
+	err := cr.GetChecklistItemById(&item, insertItem.ID, todo.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), insertItem.ID, item.ID)
+}
+
+func (s *TestChecklistItemRePositorySuite) TestUpdateChecklistItem() {
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	cr := NewChecklistItemRepository(DbCon)
+	item.Title = "test updated item 1"
+	item.Done = true
+	err := cr.UpdateChecklistItem(&item)
+
+	assert.Nil(s.T(), err)
+	updatedItem := models.ChecklistItem{}
+	DbCon.First(&updatedItem, item.ID)
+	assert.Equal(s.T(), "test updated item 1", updatedItem.Title)
+	assert.True(s.T(), updatedItem.Done)
+	// NOTE: 親のTodoのバージョンが進んでいること
+	updatedTodo := models.Todo{}
+	DbCon.First(&updatedTodo, todo.ID)
+	assert.Equal(s.T(), todo.Version+1, updatedTodo.Version)
+}
+
+func (s *TestChecklistItemRePositorySuite) TestDeleteChecklistItem() {
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	cr := NewChecklistItemRepository(DbCon)
+	err := cr.DeleteChecklistItem(&item)
+
+	assert.Nil(s.T(), err)
+	items := []models.ChecklistItem{}
+	cr.GetChecklistItems(&items, todo.ID)
+	assert.Equal(s.T(), 0, len(items))
+}
+
+func (s *TestChecklistItemRePositorySuite) TestReorderChecklistItems() {
+	items := []models.ChecklistItem{
+		{TodoID: todo.ID, Title: "test item 1", Position: 1},
+		{TodoID: todo.ID, Title: "test item 2", Position: 2},
+		{TodoID: todo.ID, Title: "test item 3", Position: 3},
+	}
+	if err := DbCon.Create(&items).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist items %v", err)
+	}
+
+	cr := NewChecklistItemRepository(DbCon)
+	err := cr.ReorderChecklistItems(todo.ID, []int{items[2].ID, items[0].ID, items[1].ID})
+
+	assert.Nil(s.T(), err)
+	reorderedItems := []models.ChecklistItem{}
+	cr.GetChecklistItems(&reorderedItems, todo.ID)
+	assert.Equal(s.T(), "test item 3", reorderedItems[0].Title)
+	assert.Equal(s.T(), "test item 1", reorderedItems[1].Title)
+	assert.Equal(s.T(), "test item 2", reorderedItems[2].Title)
+}
+
+func TestChecklistItemRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestChecklistItemRePositorySuite))
+}
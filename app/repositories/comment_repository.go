@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type CommentRepository interface {
+	CreateComment(comment *models.Comment) error
+	GetComments(comments *[]models.Comment, todoId int) error
+	GetCommentById(comment *models.Comment, id int, todoId int) error
+	UpdateComment(comment *models.Comment) error
+	DeleteComment(comment *models.Comment) error
+}
+
+type commentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) CommentRepository {
+	return &commentRepository{db}
+}
+
+func (cr *commentRepository) CreateComment(comment *models.Comment) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&comment).Error; err != nil {
+			return err
+		}
+		return cr.countComment(tx, comment.TodoID, 1)
+	})
+}
+
+func (cr *commentRepository) GetComments(comments *[]models.Comment, todoId int) error {
+	if err := cr.db.Where("todo_id = ?", todoId).Order("created_at ASC, id ASC").Find(&comments).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *commentRepository) GetCommentById(comment *models.Comment, id int, todoId int) error {
+	if err := cr.db.Where("todo_id = ?", todoId).First(&comment, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *commentRepository) UpdateComment(comment *models.Comment) error {
+	if err := cr.db.Model(&comment).Update("body", comment.Body).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *commentRepository) DeleteComment(comment *models.Comment) error {
+	return cr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&comment).Error; err != nil {
+			return err
+		}
+		return cr.countComment(tx, comment.TodoID, -1)
+	})
+}
+
+// NOTE: Todoのコメント数を更新し、レスポンスの内容が変わるためバージョンも進める
+func (cr *commentRepository) countComment(tx *gorm.DB, todoId int, delta int) error {
+	return tx.Model(&models.Todo{}).Where("id = ?", todoId).Updates(map[string]interface{}{
+		"comment_count": gorm.Expr("comment_count + ?", delta),
+		"version":       gorm.Expr("version + 1"),
+	}).Error
+}
@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestCommentRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestCommentRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestCommentRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestCommentRePositorySuite) TestCreateComment() {
+	cr := NewCommentRepository(DbCon)
+	comment := models.Comment{TodoID: todo.ID, UserID: user.ID, Body: "test comment 1"}
+	err := cr.CreateComment(&comment)
+
+	assert.Nil(s.T(), err)
+	assert.NotEqual(s.T(), 0, comment.ID)
+	// NOTE: 親のTodoのコメント数とバージョンが進んでいること
+	updatedTodo := models.Todo{}
+	DbCon.First(&updatedTodo, todo.ID)
+	assert.Equal(s.T(), 1, updatedTodo.CommentCount)
+	assert.Equal(s.T(), todo.Version+1, updatedTodo.Version)
+}
+
+func (s *TestCommentRePositorySuite) TestGetComments() {
+	comments := []models.Comment{
+		{TodoID: todo.ID, UserID: user.ID, Body: "test comment 1"},
+		{TodoID: todo.ID, UserID: user.ID, Body: "test comment 2"},
+	}
+	if err := DbCon.Create(&comments).Error; err != nil {
+		s.T().Fatalf("failed to create test comments %v", err)
+	}
+
+	cr := NewCommentRepository(DbCon)
+	fetchedComments := []models.Comment{}
+	err := cr.GetComments(&fetchedComments, todo.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), fetchedComments, 2)
+	assert.Equal(s.T(), "test comment 1", fetchedComments[0].Body)
+}
+
+func (s *TestCommentRePositorySuite) TestUpdateComment() {
+	comment := models.Comment{TodoID: todo.ID, UserID: user.ID, Body: "test comment 1"}
+	if err := DbCon.Create(&comment).Error; err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+
+	cr := NewCommentRepository(DbCon)
+	comment.Body = "test updated comment 1"
+	err := cr.UpdateComment(&comment)
+
+	assert.Nil(s.T(), err)
+	updatedComment := models.Comment{}
+	cr.GetCommentById(&updatedComment, comment.ID, todo.ID)
+	assert.Equal(s.T(), "test updated comment 1", updatedComment.Body)
+}
+
+func (s *TestCommentRePositorySuite) TestDeleteComment() {
+	cr := NewCommentRepository(DbCon)
+	comment := models.Comment{TodoID: todo.ID, UserID: user.ID, Body: "test comment 1"}
+	if err := cr.CreateComment(&comment); err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+
+	err := cr.DeleteComment(&comment)
+
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), cr.GetCommentById(&models.Comment{}, comment.ID, todo.ID))
+	updatedTodo := models.Todo{}
+	DbCon.First(&updatedTodo, todo.ID)
+	assert.Equal(s.T(), 0, updatedTodo.CommentCount)
+}
+
+func TestCommentRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestCommentRePositorySuite))
+}
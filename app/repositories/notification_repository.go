@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"app/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	CreateNotification(notification *models.Notification) error
+	GetNotifications(notifications *[]models.Notification, userId int, unreadOnly bool, limit int, offset int) error
+	CountNotifications(userId int, unreadOnly bool) (int64, error)
+	GetNotificationById(notification *models.Notification, id int, userId int) error
+	MarkNotificationRead(notification *models.Notification, readAt time.Time) error
+	MarkAllNotificationsRead(userId int, readAt time.Time) (int64, error)
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db}
+}
+
+func (nr *notificationRepository) CreateNotification(notification *models.Notification) error {
+	if err := nr.db.Omit("User", "Actor", "Todo").Create(&notification).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 新しい順に取得する
+func (nr *notificationRepository) GetNotifications(notifications *[]models.Notification, userId int, unreadOnly bool, limit int, offset int) error {
+	if err := nr.scopeNotifications(userId, unreadOnly).Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&notifications).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (nr *notificationRepository) CountNotifications(userId int, unreadOnly bool) (int64, error) {
+	var count int64
+	if err := nr.scopeNotifications(userId, unreadOnly).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (nr *notificationRepository) GetNotificationById(notification *models.Notification, id int, userId int) error {
+	if err := nr.db.Where("id = ? AND user_id = ?", id, userId).First(&notification).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 既読の場合は既読日時を更新しない
+func (nr *notificationRepository) MarkNotificationRead(notification *models.Notification, readAt time.Time) error {
+	if notification.ReadAt != nil {
+		return nil
+	}
+	if err := nr.db.Model(notification).Where("read_at IS NULL").Update("read_at", readAt).Error; err != nil {
+		return err
+	}
+	notification.ReadAt = &readAt
+
+	return nil
+}
+
+func (nr *notificationRepository) MarkAllNotificationsRead(userId int, readAt time.Time) (int64, error) {
+	result := nr.db.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", userId).Update("read_at", readAt)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (nr *notificationRepository) scopeNotifications(userId int, unreadOnly bool) *gorm.DB {
+	query := nr.db.Model(&models.Notification{}).Where("user_id = ?", userId)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	return query
+}
@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestNotificationRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestNotificationRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+}
+
+func (s *TestNotificationRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestNotificationRePositorySuite) createNotifications() []models.Notification {
+	otherUser := models.User{Name: "other", Email: "other@example.com", Password: "password"}
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	now := time.Now()
+	notifications := []models.Notification{
+		{UserID: user.ID, Type: models.NotificationTypeAssigned, Title: "test notification 1", CreatedAt: now.Add(-2 * time.Minute)},
+		{UserID: user.ID, Type: models.NotificationTypeShared, Title: "test notification 2", CreatedAt: now.Add(-time.Minute), ReadAt: &now},
+		{UserID: user.ID, Type: models.NotificationTypeCommented, Title: "test notification 3", CreatedAt: now},
+		{UserID: otherUser.ID, Type: models.NotificationTypeAssigned, Title: "other notification", CreatedAt: now},
+	}
+	if err := DbCon.Create(&notifications).Error; err != nil {
+		s.T().Fatalf("failed to create test notifications %v", err)
+	}
+	return notifications
+}
+
+func (s *TestNotificationRePositorySuite) TestGetNotifications() {
+	s.createNotifications()
+	nr := NewNotificationRepository(DbCon)
+
+	// NOTE: 自分宛ての通知を新しい順にページングして取得すること
+	notifications := []models.Notification{}
+	err := nr.GetNotifications(&notifications, user.ID, false, 2, 0)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), notifications, 2)
+	assert.Equal(s.T(), "test notification 3", notifications[0].Title)
+	assert.Equal(s.T(), "test notification 2", notifications[1].Title)
+
+	nextNotifications := []models.Notification{}
+	assert.Nil(s.T(), nr.GetNotifications(&nextNotifications, user.ID, false, 2, 2))
+	assert.Len(s.T(), nextNotifications, 1)
+	assert.Equal(s.T(), "test notification 1", nextNotifications[0].Title)
+
+	unreadNotifications := []models.Notification{}
+	assert.Nil(s.T(), nr.GetNotifications(&unreadNotifications, user.ID, true, 20, 0))
+	assert.Len(s.T(), unreadNotifications, 2)
+}
+
+func (s *TestNotificationRePositorySuite) TestCountNotifications() {
+	s.createNotifications()
+	nr := NewNotificationRepository(DbCon)
+
+	total, err := nr.CountNotifications(user.ID, false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(3), total)
+	unreadCount, err := nr.CountNotifications(user.ID, true)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(2), unreadCount)
+}
+
+func (s *TestNotificationRePositorySuite) TestMarkNotificationRead() {
+	notifications := s.createNotifications()
+	nr := NewNotificationRepository(DbCon)
+
+	// NOTE: 他のユーザの通知は取得できないこと
+	otherNotification := models.Notification{}
+	assert.NotNil(s.T(), nr.GetNotificationById(&otherNotification, notifications[3].ID, user.ID))
+
+	notification := models.Notification{}
+	assert.Nil(s.T(), nr.GetNotificationById(&notification, notifications[0].ID, user.ID))
+	err := nr.MarkNotificationRead(&notification, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), notification.ReadAt)
+	unreadCount, _ := nr.CountNotifications(user.ID, true)
+	assert.Equal(s.T(), int64(1), unreadCount)
+}
+
+func (s *TestNotificationRePositorySuite) TestMarkAllNotificationsRead() {
+	s.createNotifications()
+	nr := NewNotificationRepository(DbCon)
+
+	count, err := nr.MarkAllNotificationsRead(user.ID, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(2), count)
+	unreadCount, _ := nr.CountNotifications(user.ID, true)
+	assert.Equal(s.T(), int64(0), unreadCount)
+	// NOTE: 他のユーザの通知は既読にしないこと
+	var otherUnreadCount int64
+	DbCon.Model(&models.Notification{}).Where("user_id <> ? AND read_at IS NULL", user.ID).Count(&otherUnreadCount)
+	assert.Equal(s.T(), int64(1), otherUnreadCount)
+}
+
+func TestNotificationRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestNotificationRePositorySuite))
+}
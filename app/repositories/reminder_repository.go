@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"app/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ReminderRepository interface {
+	CreateReminder(reminder *models.Reminder) error
+	GetReminders(reminders *[]models.Reminder, todoId int, userId int) error
+	GetReminderById(reminder *models.Reminder, id int, todoId int, userId int) error
+	DeleteReminder(reminder *models.Reminder) error
+	GetDueReminders(reminders *[]models.Reminder, now time.Time) error
+	ClaimReminder(reminder *models.Reminder, now time.Time, claimedUntil time.Time) (bool, error)
+	UpdateReminderDelivery(reminder *models.Reminder) error
+}
+
+type reminderRepository struct {
+	db *gorm.DB
+}
+
+func NewReminderRepository(db *gorm.DB) ReminderRepository {
+	return &reminderRepository{db}
+}
+
+func (rr *reminderRepository) CreateReminder(reminder *models.Reminder) error {
+	if err := rr.db.Omit("Todo", "User").Create(&reminder).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rr *reminderRepository) GetReminders(reminders *[]models.Reminder, todoId int, userId int) error {
+	if err := rr.db.Where("todo_id = ? AND user_id = ?", todoId, userId).Order("id ASC").Find(&reminders).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rr *reminderRepository) GetReminderById(reminder *models.Reminder, id int, todoId int, userId int) error {
+	if err := rr.db.Where("todo_id = ? AND user_id = ?", todoId, userId).First(&reminder, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rr *reminderRepository) DeleteReminder(reminder *models.Reminder) error {
+	if err := rr.db.Delete(&reminder).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 通知日時を過ぎた可能性のある未送信のリマインダーを取得する
+// NOTE: 期日からの指定は期日の変更に追従させるため、期日が上限の分数以内のものを候補とし、通知日時はサービス層で判定する
+func (rr *reminderRepository) GetDueReminders(reminders *[]models.Reminder, now time.Time) error {
+	err := rr.db.Preload("Todo").Preload("User").
+		Joins("INNER JOIN todos ON todos.id = reminders.todo_id").
+		Where("reminders.sent_at IS NULL AND reminders.failed_at IS NULL").
+		Where("reminders.claimed_until IS NULL OR reminders.claimed_until < ?", now).
+		Where("todos.deleted_at IS NULL AND todos.status = ?", models.TodoStatusTodo).
+		Where("reminders.remind_at <= ? OR (reminders.offset_minutes IS NOT NULL AND todos.due_at <= ?)", now, now.Add(models.ReminderMaxOffsetMinutes*time.Minute)).
+		Order("reminders.id ASC").
+		Find(&reminders).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 複数のプロセスから同じリマインダーを送らないよう、claimedUntilまで配信する権利を確保する
+func (rr *reminderRepository) ClaimReminder(reminder *models.Reminder, now time.Time, claimedUntil time.Time) (bool, error) {
+	result := rr.db.Model(&models.Reminder{}).
+		Where("id = ? AND sent_at IS NULL AND (claimed_until IS NULL OR claimed_until < ?)", reminder.ID, now).
+		Update("claimed_until", claimedUntil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	reminder.ClaimedUntil = &claimedUntil
+	return result.RowsAffected == 1, nil
+}
+
+// NOTE: 配信済みのチャネルはシリアライザを通すため、mapではなく構造体で更新する(ClaimedUntilは再試行までの待機に使う)
+func (rr *reminderRepository) UpdateReminderDelivery(reminder *models.Reminder) error {
+	err := rr.db.Model(&models.Reminder{ID: reminder.ID}).
+		Select("DeliveredChannels", "Attempts", "LastError", "ClaimedUntil", "SentAt", "FailedAt").
+		Updates(reminder).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestReminderRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestReminderRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	dueAt := time.Now().Add(time.Hour)
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID, DueAt: &dueAt}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestReminderRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestReminderRePositorySuite) TestGetDueReminders() {
+	now := time.Now()
+	pastRemindAt := now.Add(-time.Minute)
+	futureRemindAt := now.Add(time.Hour)
+	offsetMinutes := 90
+	sentAt := now.Add(-time.Minute)
+	doneTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID, Status: models.TodoStatusDone}
+	if err := DbCon.Create(&doneTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	reminders := []models.Reminder{
+		{TodoID: todo.ID, UserID: user.ID, RemindAt: &pastRemindAt, Channels: []string{models.ReminderChannelInApp}},
+		{TodoID: todo.ID, UserID: user.ID, RemindAt: &futureRemindAt, Channels: []string{models.ReminderChannelInApp}},
+		{TodoID: todo.ID, UserID: user.ID, OffsetMinutes: &offsetMinutes, Channels: []string{models.ReminderChannelInApp}},
+		{TodoID: todo.ID, UserID: user.ID, RemindAt: &pastRemindAt, SentAt: &sentAt, Channels: []string{models.ReminderChannelInApp}},
+		{TodoID: doneTodo.ID, UserID: user.ID, RemindAt: &pastRemindAt, Channels: []string{models.ReminderChannelInApp}},
+	}
+	if err := DbCon.Create(&reminders).Error; err != nil {
+		s.T().Fatalf("failed to create test reminders %v", err)
+	}
+
+	rr := NewReminderRepository(DbCon)
+	dueReminders := []models.Reminder{}
+	err := rr.GetDueReminders(&dueReminders, now)
+
+	// NOTE: 期日からの指定は候補として取得し、送信済み・完了したTodoのリマインダーは含まないこと
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), dueReminders, 2)
+	assert.Equal(s.T(), reminders[0].ID, dueReminders[0].ID)
+	assert.Equal(s.T(), reminders[2].ID, dueReminders[1].ID)
+	assert.Equal(s.T(), "test title 1", dueReminders[0].Todo.Title)
+	assert.Equal(s.T(), user.Email, dueReminders[0].User.Email)
+}
+
+func (s *TestReminderRePositorySuite) TestClaimReminder() {
+	remindAt := time.Now().Add(-time.Minute)
+	reminder := models.Reminder{TodoID: todo.ID, UserID: user.ID, RemindAt: &remindAt, Channels: []string{models.ReminderChannelInApp}}
+	if err := DbCon.Create(&reminder).Error; err != nil {
+		s.T().Fatalf("failed to create test reminder %v", err)
+	}
+
+	rr := NewReminderRepository(DbCon)
+	now := time.Now()
+	claimed, err := rr.ClaimReminder(&reminder, now, now.Add(5*time.Minute))
+
+	// NOTE: 確保中は他から確保できず、取得対象にもならないこと
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), claimed)
+	claimedAgain, _ := rr.ClaimReminder(&models.Reminder{ID: reminder.ID}, now, now.Add(5*time.Minute))
+	assert.False(s.T(), claimedAgain)
+	dueReminders := []models.Reminder{}
+	rr.GetDueReminders(&dueReminders, now)
+	assert.Len(s.T(), dueReminders, 0)
+}
+
+func (s *TestReminderRePositorySuite) TestUpdateReminderDelivery() {
+	remindAt := time.Now().Add(-time.Minute)
+	reminder := models.Reminder{TodoID: todo.ID, UserID: user.ID, RemindAt: &remindAt, Channels: []string{models.ReminderChannelEmail, models.ReminderChannelInApp}}
+	if err := DbCon.Create(&reminder).Error; err != nil {
+		s.T().Fatalf("failed to create test reminder %v", err)
+	}
+
+	rr := NewReminderRepository(DbCon)
+	reminder.DeliveredChannels = []string{models.ReminderChannelInApp}
+	reminder.Attempts = 1
+	reminder.LastError = "email: notification channel is not configured"
+	err := rr.UpdateReminderDelivery(&reminder)
+
+	assert.Nil(s.T(), err)
+	fetchedReminder := models.Reminder{}
+	assert.Nil(s.T(), rr.GetReminderById(&fetchedReminder, reminder.ID, todo.ID, user.ID))
+	assert.Equal(s.T(), []string{models.ReminderChannelInApp}, fetchedReminder.DeliveredChannels)
+	assert.Equal(s.T(), 1, fetchedReminder.Attempts)
+	assert.Nil(s.T(), fetchedReminder.SentAt)
+}
+
+func TestReminderRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestReminderRePositorySuite))
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type TagRepository interface {
+	ReplaceTodoTags(todo *models.Todo, names []string) error
+	GetTagNames(names *[]string, userId int) error
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{db}
+}
+
+// NOTE: Todoのタグを指定されたものに置き換え、Todoのバージョンを進める
+func (tr *tagRepository) ReplaceTodoTags(todo *models.Todo, names []string) error {
+	todoTags := []models.TodoTag{}
+	for _, name := range names {
+		todoTags = append(todoTags, models.TodoTag{TodoID: todo.ID, Name: name})
+	}
+
+	err := tr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("todo_id = ?", todo.ID).Delete(&models.TodoTag{}).Error; err != nil {
+			return err
+		}
+		if len(todoTags) > 0 {
+			if err := tx.Create(&todoTags).Error; err != nil {
+				return err
+			}
+		}
+		// NOTE: 読み込み済みの古いタグが保存し直されないようTodoの構造体は渡さない
+		return tx.Model(&models.Todo{}).Where("id = ?", todo.ID).Update("version", gorm.Expr("version + 1")).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	todo.TodoTags = todoTags
+	todo.Tags = names
+	todo.Version++
+	return nil
+}
+
+// NOTE: ゴミ箱のTodoのタグは含めない
+func (tr *tagRepository) GetTagNames(names *[]string, userId int) error {
+	err := tr.db.Model(&models.TodoTag{}).
+		Joins("INNER JOIN todos ON todos.id = todo_tags.todo_id").
+		Where("todos.user_id = ? AND todos.deleted_at IS NULL", userId).
+		Distinct("todo_tags.name").
+		Order("todo_tags.name ASC").
+		Pluck("todo_tags.name", names).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
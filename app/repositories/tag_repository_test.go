@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTagRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestTagRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestTagRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTagRePositorySuite) TestReplaceTodoTags() {
+	if err := DbCon.Create(&models.TodoTag{TodoID: todo.ID, Name: "old"}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo tag %v", err)
+	}
+
+	tr := NewTagRepository(DbCon)
+	err := tr.ReplaceTodoTags(&todo, []string{"home", "work"})
+
+	assert.Nil(s.T(), err)
+	// NOTE: 取得時にタグ名の一覧が設定されること
+	fetchedTodo := models.Todo{}
+	NewTodoRepository(DbCon).FindTodoById(&fetchedTodo, todo.ID)
+	assert.Equal(s.T(), []string{"home", "work"}, fetchedTodo.Tags)
+	assert.Equal(s.T(), todo.Version, fetchedTodo.Version)
+}
+
+func (s *TestTagRePositorySuite) TestGetTagNames() {
+	trashedTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID}
+	if err := DbCon.Create(&trashedTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	todoTags := []models.TodoTag{
+		{TodoID: todo.ID, Name: "work"},
+		{TodoID: todo.ID, Name: "home"},
+		{TodoID: trashedTodo.ID, Name: "trashed"},
+	}
+	if err := DbCon.Create(&todoTags).Error; err != nil {
+		s.T().Fatalf("failed to create test todo tags %v", err)
+	}
+	DbCon.Delete(&trashedTodo)
+
+	tr := NewTagRepository(DbCon)
+	names := []string{}
+	err := tr.GetTagNames(&names, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"home", "work"}, names)
+}
+
+func TestTagRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTagRePositorySuite))
+}
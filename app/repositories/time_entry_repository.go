@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"app/models"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// NOTE: 既に計測中のタイマーがあるユーザのタイマーを登録しようとした場合のエラー
+var ErrTimeEntryAlreadyRunning = errors.New("another timer is already running")
+
+type TimeEntryRepository interface {
+	CreateTimeEntry(entry *models.TimeEntry) error
+	GetRunningTimeEntry(entry *models.TimeEntry, userId int) error
+	StopTimeEntry(entry *models.TimeEntry, endedAt time.Time) error
+	GetTimeEntries(entries *[]models.TimeEntry, todoId int) error
+	GetTimeEntriesInRange(entries *[]models.TimeEntry, userId int, from time.Time, until time.Time) error
+}
+
+type timeEntryRepository struct {
+	db *gorm.DB
+}
+
+func NewTimeEntryRepository(db *gorm.DB) TimeEntryRepository {
+	return &timeEntryRepository{db}
+}
+
+// NOTE: 同時に開始された場合も計測中のタイマーが1つになるよう、一意制約の違反は計測中のタイマーがあるものとして扱う
+func (ter *timeEntryRepository) CreateTimeEntry(entry *models.TimeEntry) error {
+	if err := ter.db.Create(&entry).Error; err != nil {
+		if translator, ok := ter.db.Dialector.(gorm.ErrorTranslator); ok && errors.Is(translator.Translate(err), gorm.ErrDuplicatedKey) {
+			return ErrTimeEntryAlreadyRunning
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (ter *timeEntryRepository) GetRunningTimeEntry(entry *models.TimeEntry, userId int) error {
+	if err := ter.db.Where("user_id = ? AND ended_at IS NULL", userId).First(&entry).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 計測中の場合のみ終了させる(同時に停止された場合は後者を無視する)
+func (ter *timeEntryRepository) StopTimeEntry(entry *models.TimeEntry, endedAt time.Time) error {
+	durationSeconds := int64(endedAt.Sub(entry.StartedAt).Seconds())
+	result := ter.db.Model(&models.TimeEntry{}).Where("id = ? AND ended_at IS NULL", entry.ID).Updates(map[string]interface{}{
+		"ended_at":         endedAt,
+		"duration_seconds": durationSeconds,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	entry.EndedAt = &endedAt
+	entry.DurationSeconds = durationSeconds
+	return nil
+}
+
+func (ter *timeEntryRepository) GetTimeEntries(entries *[]models.TimeEntry, todoId int) error {
+	if err := ter.db.Where("todo_id = ?", todoId).Order("started_at ASC, id ASC").Find(&entries).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 期間に一部でも重なる終了済みの記録を取得する(ゴミ箱のTodoの記録も含める)
+func (ter *timeEntryRepository) GetTimeEntriesInRange(entries *[]models.TimeEntry, userId int, from time.Time, until time.Time) error {
+	err := ter.db.Preload("Todo", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Preload("Todo.TodoTags").
+		Where("user_id = ? AND ended_at IS NOT NULL AND started_at < ? AND ended_at > ?", userId, until, from).
+		Order("started_at ASC, id ASC").
+		Find(&entries).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTimeEntryRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestTimeEntryRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestTimeEntryRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTimeEntryRePositorySuite) TestGetRunningTimeEntry() {
+	endedAt := time.Now().Add(-time.Hour)
+	entries := []models.TimeEntry{
+		{TodoID: todo.ID, UserID: user.ID, StartedAt: endedAt.Add(-time.Hour), EndedAt: &endedAt, DurationSeconds: 3600},
+		{TodoID: todo.ID, UserID: user.ID, StartedAt: time.Now()},
+	}
+	if err := DbCon.Create(&entries).Error; err != nil {
+		s.T().Fatalf("failed to create test time entries %v", err)
+	}
+
+	ter := NewTimeEntryRepository(DbCon)
+	running := models.TimeEntry{}
+	err := ter.GetRunningTimeEntry(&running, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), entries[1].ID, running.ID)
+}
+
+func (s *TestTimeEntryRePositorySuite) TestCreateTimeEntry_AlreadyRunning() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	ter := NewTimeEntryRepository(DbCon)
+	endedAt := time.Now()
+
+	assert.Nil(s.T(), ter.CreateTimeEntry(&models.TimeEntry{TodoID: todo.ID, UserID: user.ID, StartedAt: time.Now()}))
+	// NOTE: 終了済みの記録や別のユーザのタイマーは登録できること
+	assert.Nil(s.T(), ter.CreateTimeEntry(&models.TimeEntry{TodoID: todo.ID, UserID: user.ID, StartedAt: endedAt.Add(-time.Hour), EndedAt: &endedAt}))
+	assert.Nil(s.T(), ter.CreateTimeEntry(&models.TimeEntry{TodoID: todo.ID, UserID: otherUser.ID, StartedAt: time.Now()}))
+	// NOTE: 計測中のタイマーが既にあるユーザのタイマーは登録できないこと
+	err := ter.CreateTimeEntry(&models.TimeEntry{TodoID: todo.ID, UserID: user.ID, StartedAt: time.Now()})
+	assert.ErrorIs(s.T(), err, ErrTimeEntryAlreadyRunning)
+}
+
+func (s *TestTimeEntryRePositorySuite) TestStopTimeEntry() {
+	entry := models.TimeEntry{TodoID: todo.ID, UserID: user.ID, StartedAt: time.Now().Add(-time.Hour)}
+	if err := DbCon.Create(&entry).Error; err != nil {
+		s.T().Fatalf("failed to create test time entry %v", err)
+	}
+
+	ter := NewTimeEntryRepository(DbCon)
+	err := ter.StopTimeEntry(&entry, entry.StartedAt.Add(90*time.Minute))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(5400), entry.DurationSeconds)
+	// NOTE: 停止済みの記録は再度停止できないこと
+	assert.NotNil(s.T(), ter.StopTimeEntry(&entry, time.Now()))
+}
+
+func (s *TestTimeEntryRePositorySuite) TestGetTimeEntriesInRange() {
+	from := time.Date(2026, 1, 10, 0, 0, 0, 0, time.Local)
+	until := from.AddDate(0, 0, 1)
+	ended := []time.Time{from.Add(-time.Hour), from.Add(time.Hour), until.Add(2 * time.Hour)}
+	entries := []models.TimeEntry{
+		{TodoID: todo.ID, UserID: user.ID, StartedAt: from.Add(-2 * time.Hour), EndedAt: &ended[0]},
+		{TodoID: todo.ID, UserID: user.ID, StartedAt: from.Add(-time.Hour), EndedAt: &ended[1]},
+		{TodoID: todo.ID, UserID: user.ID, StartedAt: until.Add(time.Hour), EndedAt: &ended[2]},
+		{TodoID: todo.ID, UserID: user.ID, StartedAt: from.Add(3 * time.Hour)},
+	}
+	if err := DbCon.Create(&entries).Error; err != nil {
+		s.T().Fatalf("failed to create test time entries %v", err)
+	}
+
+	ter := NewTimeEntryRepository(DbCon)
+	fetchedEntries := []models.TimeEntry{}
+	err := ter.GetTimeEntriesInRange(&fetchedEntries, user.ID, from, until)
+
+	// NOTE: 期間に重なる終了済みの記録のみ取得されること
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), fetchedEntries, 1)
+	assert.Equal(s.T(), entries[1].ID, fetchedEntries[0].ID)
+	assert.Equal(s.T(), "test title 1", fetchedEntries[0].Todo.Title)
+}
+
+func TestTimeEntryRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTimeEntryRePositorySuite))
+}
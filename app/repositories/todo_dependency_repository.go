@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"app/models"
+	"errors"
+	"slices"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NOTE: 登録すると依存関係が循環する場合のエラー
+var ErrTodoDependencyCycle = errors.New("dependency would create a cycle")
+
+type TodoDependencyRepository interface {
+	CreateTodoDependency(dependency *models.TodoDependency) error
+	GetBlockers(todos *[]models.Todo, todoId int) error
+	GetTodoDependency(dependency *models.TodoDependency, todoId int, blockerId int) error
+	DeleteTodoDependency(dependency *models.TodoDependency) error
+	HasDependencyPath(fromId int, toId int) (bool, error)
+}
+
+type todoDependencyRepository struct {
+	db *gorm.DB
+}
+
+func NewTodoDependencyRepository(db *gorm.DB) TodoDependencyRepository {
+	return &todoDependencyRepository{db}
+}
+
+// NOTE: ブロッカーの追加・削除でblockedが変わるため、ブロックされるTodoのバージョンも更新する
+// NOTE: 同時に登録された依存関係で循環しないよう、循環の確認と登録をロックを取得した1つのトランザクションで行う
+func (tdr *todoDependencyRepository) CreateTodoDependency(dependency *models.TodoDependency) error {
+	return tdr.db.Transaction(func(tx *gorm.DB) error {
+		todos := []models.Todo{}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "user_id").Where("id IN ?", []int{dependency.TodoID, dependency.BlockerID}).Order("id ASC").Find(&todos).Error; err != nil {
+			return err
+		}
+		if len(todos) != 2 {
+			return gorm.ErrRecordNotFound
+		}
+		// NOTE: 3件以上を経由する循環は対象の2件のロックだけでは防げないため、依存関係を登録できる範囲(作成者)単位でもロックする
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&models.User{}, todos[0].UserID).Error; err != nil {
+			return err
+		}
+
+		cyclic, err := hasDependencyPath(tx, dependency.BlockerID, dependency.TodoID)
+		if err != nil {
+			return err
+		}
+		if cyclic {
+			return ErrTodoDependencyCycle
+		}
+		if err := tx.Create(&dependency).Error; err != nil {
+			return err
+		}
+		return tdr.touchTodo(tx, dependency.TodoID)
+	})
+}
+
+func (tdr *todoDependencyRepository) GetBlockers(todos *[]models.Todo, todoId int) error {
+	err := tdr.db.Joins("INNER JOIN todo_dependencies ON todo_dependencies.blocker_id = todos.id").
+		Where("todo_dependencies.todo_id = ?", todoId).
+		Order("todos.id ASC").
+		Find(&todos).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tdr *todoDependencyRepository) GetTodoDependency(dependency *models.TodoDependency, todoId int, blockerId int) error {
+	if err := tdr.db.Where("todo_id = ? AND blocker_id = ?", todoId, blockerId).First(&dependency).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tdr *todoDependencyRepository) DeleteTodoDependency(dependency *models.TodoDependency) error {
+	return tdr.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&dependency).Error; err != nil {
+			return err
+		}
+		return tdr.touchTodo(tx, dependency.TodoID)
+	})
+}
+
+// NOTE: fromIdのTodoからブロッカーを辿ってtoIdのTodoに到達できるか(ゴミ箱のTodoを含む)
+func (tdr *todoDependencyRepository) HasDependencyPath(fromId int, toId int) (bool, error) {
+	return hasDependencyPath(tdr.db, fromId, toId)
+}
+
+func hasDependencyPath(db *gorm.DB, fromId int, toId int) (bool, error) {
+	visited := []int{fromId}
+	frontier := []int{fromId}
+	for len(frontier) > 0 {
+		blockerIds := []int{}
+		if err := db.Model(&models.TodoDependency{}).Where("todo_id IN ?", frontier).Pluck("blocker_id", &blockerIds).Error; err != nil {
+			return false, err
+		}
+
+		frontier = []int{}
+		for _, blockerId := range blockerIds {
+			if blockerId == toId {
+				return true, nil
+			}
+			if slices.Contains(visited, blockerId) {
+				continue
+			}
+			visited = append(visited, blockerId)
+			frontier = append(frontier, blockerId)
+		}
+	}
+	return false, nil
+}
+
+func (tdr *todoDependencyRepository) touchTodo(tx *gorm.DB, todoId int) error {
+	return tx.Model(&models.Todo{}).Where("id = ?", todoId).Update("version", gorm.Expr("version + 1")).Error
+}
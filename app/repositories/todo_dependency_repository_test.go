@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoDependencyRePositorySuite struct {
+	WithDbSuite
+}
+
+var blockerTodos []models.Todo
+
+func (s *TestTodoDependencyRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	blockerTodos = []models.Todo{
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+		{Title: "test title 3", Content: "test content 3", UserID: user.ID},
+	}
+	if err := DbCon.Create(&blockerTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+}
+
+func (s *TestTodoDependencyRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoDependencyRePositorySuite) TestCreateTodoDependency() {
+	tdr := NewTodoDependencyRepository(DbCon)
+	dependency := models.TodoDependency{TodoID: todo.ID, BlockerID: blockerTodos[0].ID}
+	err := tdr.CreateTodoDependency(&dependency)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 未完了のブロッカーがあるためblockedになること
+	fetchedTodo := models.Todo{}
+	NewTodoRepository(DbCon).FindTodoById(&fetchedTodo, todo.ID)
+	assert.True(s.T(), fetchedTodo.Blocked)
+	// NOTE: blockedが変わるためバージョンが更新されること
+	assert.Equal(s.T(), todo.Version+1, fetchedTodo.Version)
+}
+
+func (s *TestTodoDependencyRePositorySuite) TestCreateTodoDependency_Cycle() {
+	// NOTE: todo <- blockerTodos[0] <- blockerTodos[1] の順にブロックされている
+	tdr := NewTodoDependencyRepository(DbCon)
+	assert.Nil(s.T(), tdr.CreateTodoDependency(&models.TodoDependency{TodoID: todo.ID, BlockerID: blockerTodos[0].ID}))
+	assert.Nil(s.T(), tdr.CreateTodoDependency(&models.TodoDependency{TodoID: blockerTodos[0].ID, BlockerID: blockerTodos[1].ID}))
+
+	// NOTE: 循環する依存関係は登録時に拒否され、登録されないこと
+	err := tdr.CreateTodoDependency(&models.TodoDependency{TodoID: blockerTodos[1].ID, BlockerID: todo.ID})
+	assert.ErrorIs(s.T(), err, ErrTodoDependencyCycle)
+	fetchedDependency := models.TodoDependency{}
+	assert.NotNil(s.T(), tdr.GetTodoDependency(&fetchedDependency, blockerTodos[1].ID, todo.ID))
+}
+
+func (s *TestTodoDependencyRePositorySuite) TestGetBlockers() {
+	dependencies := []models.TodoDependency{
+		{TodoID: todo.ID, BlockerID: blockerTodos[0].ID},
+		{TodoID: todo.ID, BlockerID: blockerTodos[1].ID},
+	}
+	if err := DbCon.Create(&dependencies).Error; err != nil {
+		s.T().Fatalf("failed to create test dependencies %v", err)
+	}
+	DbCon.Delete(&blockerTodos[1])
+
+	tdr := NewTodoDependencyRepository(DbCon)
+	blockers := []models.Todo{}
+	err := tdr.GetBlockers(&blockers, todo.ID)
+
+	// NOTE: ゴミ箱のTodoは含まれないこと
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), blockers, 1)
+	assert.Equal(s.T(), blockerTodos[0].ID, blockers[0].ID)
+}
+
+func (s *TestTodoDependencyRePositorySuite) TestHasDependencyPath() {
+	// NOTE: todo <- blockerTodos[0] <- blockerTodos[1] の順にブロックされている
+	dependencies := []models.TodoDependency{
+		{TodoID: todo.ID, BlockerID: blockerTodos[0].ID},
+		{TodoID: blockerTodos[0].ID, BlockerID: blockerTodos[1].ID},
+	}
+	if err := DbCon.Create(&dependencies).Error; err != nil {
+		s.T().Fatalf("failed to create test dependencies %v", err)
+	}
+
+	tdr := NewTodoDependencyRepository(DbCon)
+	found, err := tdr.HasDependencyPath(todo.ID, blockerTodos[1].ID)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), found)
+
+	found, err = tdr.HasDependencyPath(blockerTodos[1].ID, todo.ID)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), found)
+}
+
+func (s *TestTodoDependencyRePositorySuite) TestDeleteTodoDependency() {
+	dependency := models.TodoDependency{TodoID: todo.ID, BlockerID: blockerTodos[0].ID}
+	if err := DbCon.Create(&dependency).Error; err != nil {
+		s.T().Fatalf("failed to create test dependency %v", err)
+	}
+
+	tdr := NewTodoDependencyRepository(DbCon)
+	err := tdr.DeleteTodoDependency(&dependency)
+
+	assert.Nil(s.T(), err)
+	fetchedDependency := models.TodoDependency{}
+	assert.NotNil(s.T(), tdr.GetTodoDependency(&fetchedDependency, todo.ID, blockerTodos[0].ID))
+	fetchedTodo := models.Todo{}
+	NewTodoRepository(DbCon).FindTodoById(&fetchedTodo, todo.ID)
+	assert.Equal(s.T(), todo.Version+1, fetchedTodo.Version)
+}
+
+func (s *TestTodoDependencyRePositorySuite) TestBlockerStatusChange() {
+	dependency := models.TodoDependency{TodoID: todo.ID, BlockerID: blockerTodos[0].ID}
+	if err := DbCon.Create(&dependency).Error; err != nil {
+		s.T().Fatalf("failed to create test dependency %v", err)
+	}
+	tr := NewTodoRepository(DbCon)
+	blocker := blockerTodos[0]
+
+	// NOTE: ブロッカーの完了・再開で依存しているTodoのバージョンが更新されること
+	assert.Nil(s.T(), tr.CompleteTodo(&blocker, false, nil))
+	completedTodo := models.Todo{}
+	tr.FindTodoById(&completedTodo, todo.ID)
+	assert.False(s.T(), completedTodo.Blocked)
+	assert.Equal(s.T(), todo.Version+1, completedTodo.Version)
+
+	assert.Nil(s.T(), tr.ReopenTodo(&blocker))
+	reopenedTodo := models.Todo{}
+	tr.FindTodoById(&reopenedTodo, todo.ID)
+	assert.True(s.T(), reopenedTodo.Blocked)
+	assert.Equal(s.T(), todo.Version+2, reopenedTodo.Version)
+
+	// NOTE: 状態が変わらない更新では依存しているTodoのバージョンを更新しないこと
+	blocker.Title = "test updated title"
+	assert.Nil(s.T(), tr.UpdateTodo(&blocker))
+	updatedTodo := models.Todo{}
+	tr.FindTodoById(&updatedTodo, todo.ID)
+	assert.Equal(s.T(), todo.Version+2, updatedTodo.Version)
+}
+
+func TestTodoDependencyRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoDependencyRePositorySuite))
+}
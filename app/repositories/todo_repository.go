@@ -2,16 +2,43 @@ package repositories
 
 import (
 	"app/models"
+	"app/utils"
+	"errors"
+	"time"
 
 	"gorm.io/gorm"
 )
 
+// NOTE: 取得時から他のリクエストによってTodoが更新されていた場合のエラー
+var ErrTodoVersionConflict = errors.New("todo has been modified by another request")
+
 type TodoRepository interface {
 	CreateTodo(todo *models.Todo) error
 	GetAllTodos(todos *[]models.Todo, userId int) error
+	GetTodos(todos *[]models.Todo, filter models.TodoFilter, now time.Time, userId int) error
 	GetTodoById(todo *models.Todo, id int, userId int) error
+	FindTodoById(todo *models.Todo, id int) error
+	GetAssignedTodos(todos *[]models.Todo, userId int) error
+	GetSharedTodos(todos *[]models.Todo, userId int) error
+	AssignTodo(todo *models.Todo, assigneeId *int) error
 	UpdateTodo(todo *models.Todo) error
 	DeleteTodo(todo *models.Todo) error
+	CompleteTodo(todo *models.Todo, completeChecklistItems bool, nextTodo *models.Todo) error
+	ReopenTodo(todo *models.Todo) error
+	ArchiveTodo(todo *models.Todo) error
+	UnarchiveTodo(todo *models.Todo) error
+	ArchiveTodosCompletedBefore(userId int, completedBefore time.Time) (int64, error)
+	GetTrashedTodos(todos *[]models.Todo, userId int) error
+	GetTrashedTodoById(todo *models.Todo, id int, userId int) error
+	RestoreTodo(todo *models.Todo) error
+	PurgeTodo(todo *models.Todo) error
+	PurgeTrashedTodos(userId int) (int64, error)
+	PurgeTodosDeletedBefore(deletedBefore time.Time) (int64, error)
+	GetTodosLastModifiedAt(lastModifiedAt *time.Time, userId int) error
+	GetPreviousTodoByPosition(todo *models.Todo, position string, excludeId int, userId int) error
+	GetNextTodoByPosition(todo *models.Todo, position string, excludeId int, userId int) error
+	MoveTodo(todo *models.Todo, position string) error
+	RebalanceTodoPositions(userId int) error
 }
 
 type todoRepository struct {
@@ -23,15 +50,50 @@ func NewTodoRepository(db *gorm.DB) TodoRepository {
 }
 
 func (tr *todoRepository) CreateTodo(todo *models.Todo) error {
+	if err := tr.setLastPosition(tr.db, todo); err != nil {
+		return err
+	}
 	if err := tr.db.Create(&todo).Error; err != nil {
 		return err
 	}
 
+	if len(todo.Position) > models.TodoPositionMaxLength {
+		return tr.RebalanceTodoPositions(todo.UserID)
+	}
 	return nil
 }
 
 func (tr *todoRepository) GetAllTodos(todos *[]models.Todo, userId int) error {
-	if err := tr.db.Where("user_id = ?", userId).Find(&todos).Error; err != nil {
+	return tr.GetTodos(todos, models.TodoFilter{}, time.Now(), userId)
+}
+
+// NOTE: 一覧と保存したビューで共通の絞り込み(期限の範囲はnowを基準に算出する)
+func (tr *todoRepository) GetTodos(todos *[]models.Todo, filter models.TodoFilter, now time.Time, userId int) error {
+	db := tr.preloadAssociations(tr.db).Where("user_id = ?", userId)
+	if !filter.IncludeArchived {
+		db = db.Where("archived_at IS NULL")
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if filter.Overdue {
+		db = db.Where("status = ?", models.TodoStatusTodo)
+	}
+	if len(filter.Priorities) > 0 {
+		db = db.Where("priority IN ?", filter.Priorities)
+	}
+	if len(filter.Tags) > 0 {
+		db = db.Where("id IN (?)", tr.db.Model(&models.TodoTag{}).Select("todo_id").Where("name IN ?", filter.Tags))
+	}
+	dueFrom, dueUntil := filter.DueRange(now)
+	if dueFrom != nil {
+		db = db.Where("due_at >= ?", *dueFrom)
+	}
+	if dueUntil != nil {
+		db = db.Where("due_at < ?", *dueUntil)
+	}
+
+	if err := db.Order("position ASC, id ASC").Find(&todos).Error; err != nil {
 		return err
 	}
 
@@ -39,29 +101,420 @@ func (tr *todoRepository) GetAllTodos(todos *[]models.Todo, userId int) error {
 }
 
 func (tr *todoRepository) GetTodoById(todo *models.Todo, id int, userId int) error {
-	if err := tr.db.Where("user_id = ?", userId).First(&todo, id).Error; err != nil {
+	if err := tr.preloadAssociations(tr.db).Where("user_id = ?", userId).First(&todo, id).Error; err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (tr *todoRepository) UpdateTodo(todo *models.Todo) error {
+// NOTE: 作成者を問わず取得する(参照可否はサービス層で判定する)
+func (tr *todoRepository) FindTodoById(todo *models.Todo, id int) error {
+	if err := tr.preloadAssociations(tr.db).First(&todo, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *todoRepository) GetAssignedTodos(todos *[]models.Todo, userId int) error {
+	err := tr.preloadAssociations(tr.db).
+		Where("assignee_id = ?", userId).
+		Order("due_at IS NULL, due_at ASC, id ASC").
+		Find(&todos).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *todoRepository) GetSharedTodos(todos *[]models.Todo, userId int) error {
+	err := tr.preloadAssociations(tr.db).
+		Joins("INNER JOIN todo_shares ON todo_shares.todo_id = todos.id").
+		Where("todo_shares.user_id = ?", userId).
+		Order("todos.due_at IS NULL, todos.due_at ASC, todos.id ASC").
+		Find(&todos).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *todoRepository) AssignTodo(todo *models.Todo, assigneeId *int) error {
 	err := tr.db.Model(&todo).Updates(map[string]interface{}{
-		"title":   todo.Title,
-		"content": todo.Content,
+		"assignee_id": assigneeId,
+		"version":     gorm.Expr("version + 1"),
 	}).Error
 	if err != nil {
 		return err
 	}
 
+	todo.AssigneeID = assigneeId
+	todo.Version++
 	return nil
 }
 
+// NOTE: 取得時のバージョンと一致する場合のみ更新し、バージョンを進める
+// NOTE: 状態が変わった場合は、依存しているTodoのブロック状態も変わるためバージョンを更新する
+func (tr *todoRepository) UpdateTodo(todo *models.Todo) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		previousStatuses := []string{}
+		if err := tx.Model(&models.Todo{}).Where("id = ?", todo.ID).Pluck("status", &previousStatuses).Error; err != nil {
+			return err
+		}
+		result := tx.Model(&models.Todo{}).Where("id = ? AND version = ?", todo.ID, todo.Version).Updates(map[string]interface{}{
+			"title":               todo.Title,
+			"content":             todo.Content,
+			"status":              todo.Status,
+			"priority":            todo.Priority,
+			"completed_at":        todo.CompletedAt,
+			"archived_at":         todo.ArchivedAt,
+			"due_at":              todo.DueAt,
+			"recurrence_rule":     todo.RecurrenceRule,
+			"recurrence_start_at": todo.RecurrenceStartAt,
+			"version":             gorm.Expr("version + 1"),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTodoVersionConflict
+		}
+
+		todo.Version++
+		if len(previousStatuses) > 0 && previousStatuses[0] != todo.Status {
+			return tr.touchDependents(tx, todo.ID)
+		}
+		return nil
+	})
+}
+
+// NOTE: 一覧の更新日時に削除を反映させるため、論理削除時もupdated_atとバージョンを更新する(ゴミ箱のブロッカーは対象外となるため依存しているTodoも更新する)
 func (tr *todoRepository) DeleteTodo(todo *models.Todo) error {
-	if err := tr.db.Delete(&todo).Error; err != nil {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&todo).Where("version = ?", todo.Version).Updates(map[string]interface{}{
+			"deleted_at": time.Now(),
+			"version":    gorm.Expr("version + 1"),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTodoVersionConflict
+		}
+
+		return tr.touchDependents(tx, todo.ID)
+	})
+}
+
+func (tr *todoRepository) CompleteTodo(todo *models.Todo, completeChecklistItems bool, nextTodo *models.Todo) error {
+	completedAt := time.Now()
+	err := tr.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&todo).Updates(map[string]interface{}{
+			"status":       models.TodoStatusDone,
+			"completed_at": completedAt,
+			"version":      gorm.Expr("version + 1"),
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := tr.touchDependents(tx, todo.ID); err != nil {
+			return err
+		}
+
+		if completeChecklistItems {
+			// NOTE: 親のTodoと合わせてチェックリストも全て完了にする
+			if err := tx.Model(&models.ChecklistItem{}).Where("todo_id = ?", todo.ID).Update("done", true).Error; err != nil {
+				return err
+			}
+		}
+
+		if nextTodo == nil {
+			return nil
+		}
+		// NOTE: 繰り返しTodoの次回分を作成する
+		if err := tr.setLastPosition(tx, nextTodo); err != nil {
+			return err
+		}
+		return tx.Create(&nextTodo).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	todo.Status = models.TodoStatusDone
+	todo.CompletedAt = &completedAt
+	todo.Version++
+	if completeChecklistItems {
+		for i := range todo.ChecklistItems {
+			todo.ChecklistItems[i].Done = true
+		}
+		todo.ChecklistProgress = models.NewChecklistProgress(todo.ChecklistItems)
+	}
+	return nil
+}
+
+// NOTE: 未完了に戻したTodoはアーカイブも解除する
+func (tr *todoRepository) ReopenTodo(todo *models.Todo) error {
+	err := tr.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&todo).Updates(map[string]interface{}{
+			"status":       models.TodoStatusTodo,
+			"completed_at": nil,
+			"archived_at":  nil,
+			"version":      gorm.Expr("version + 1"),
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tr.touchDependents(tx, todo.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	todo.Status = models.TodoStatusTodo
+	todo.CompletedAt = nil
+	todo.ArchivedAt = nil
+	todo.Version++
+	return nil
+}
+
+func (tr *todoRepository) ArchiveTodo(todo *models.Todo) error {
+	archivedAt := time.Now()
+	err := tr.db.Model(&todo).Updates(map[string]interface{}{
+		"archived_at": archivedAt,
+		"version":     gorm.Expr("version + 1"),
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	todo.ArchivedAt = &archivedAt
+	todo.Version++
+	return nil
+}
+
+func (tr *todoRepository) UnarchiveTodo(todo *models.Todo) error {
+	err := tr.db.Model(&todo).Updates(map[string]interface{}{
+		"archived_at": nil,
+		"version":     gorm.Expr("version + 1"),
+	}).Error
+	if err != nil {
 		return err
 	}
 
+	todo.ArchivedAt = nil
+	todo.Version++
 	return nil
 }
+
+// NOTE: 完了日時がcompletedBeforeより前の未アーカイブのTodoをまとめてアーカイブする
+func (tr *todoRepository) ArchiveTodosCompletedBefore(userId int, completedBefore time.Time) (int64, error) {
+	result := tr.db.Model(&models.Todo{}).
+		Where("user_id = ? AND status = ? AND archived_at IS NULL AND completed_at < ?", userId, models.TodoStatusDone, completedBefore).
+		Updates(map[string]interface{}{
+			"archived_at": time.Now(),
+			"version":     gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (tr *todoRepository) GetTrashedTodos(todos *[]models.Todo, userId int) error {
+	err := tr.preloadAssociations(tr.db.Unscoped()).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userId).
+		Order("deleted_at DESC").
+		Find(&todos).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *todoRepository) GetTrashedTodoById(todo *models.Todo, id int, userId int) error {
+	err := tr.preloadAssociations(tr.db.Unscoped()).
+		Where("user_id = ? AND deleted_at IS NOT NULL", userId).
+		First(&todo, id).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *todoRepository) RestoreTodo(todo *models.Todo) error {
+	err := tr.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&todo).Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tr.touchDependents(tx, todo.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	todo.DeletedAt = gorm.DeletedAt{}
+	todo.Version++
+	return nil
+}
+
+// NOTE: ゴミ箱のTodoを物理削除する(チェックリストは外部キー制約により合わせて削除される)
+func (tr *todoRepository) PurgeTodo(todo *models.Todo) error {
+	if err := tr.db.Unscoped().Delete(&todo).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tr *todoRepository) PurgeTrashedTodos(userId int) (int64, error) {
+	result := tr.db.Unscoped().Where("user_id = ? AND deleted_at IS NOT NULL", userId).Delete(&models.Todo{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (tr *todoRepository) PurgeTodosDeletedBefore(deletedBefore time.Time) (int64, error) {
+	result := tr.db.Unscoped().Where("deleted_at < ?", deletedBefore).Delete(&models.Todo{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// NOTE: ゴミ箱のTodoも含めた最終更新日時(Todoが無い場合はゼロ値)
+func (tr *todoRepository) GetTodosLastModifiedAt(lastModifiedAt *time.Time, userId int) error {
+	todos := []models.Todo{}
+	err := tr.db.Unscoped().Select("updated_at").Where("user_id = ?", userId).Order("updated_at DESC").Limit(1).Find(&todos).Error
+	if err != nil {
+		return err
+	}
+
+	*lastModifiedAt = time.Time{}
+	if len(todos) > 0 {
+		*lastModifiedAt = todos[0].UpdatedAt
+	}
+	return nil
+}
+
+// NOTE: 指定した並び順より前にある直前のTodo(無い場合はゼロ値)
+func (tr *todoRepository) GetPreviousTodoByPosition(todo *models.Todo, position string, excludeId int, userId int) error {
+	err := tr.db.Where("user_id = ? AND position < ? AND id <> ?", userId, position, excludeId).
+		Order("position DESC").Limit(1).Find(&todo).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 指定した並び順より後にある直後のTodo(無い場合はゼロ値)
+func (tr *todoRepository) GetNextTodoByPosition(todo *models.Todo, position string, excludeId int, userId int) error {
+	err := tr.db.Where("user_id = ? AND position > ? AND id <> ?", userId, position, excludeId).
+		Order("position ASC").Limit(1).Find(&todo).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 移動するTodoの1行のみ更新する
+func (tr *todoRepository) MoveTodo(todo *models.Todo, position string) error {
+	err := tr.db.Model(&todo).Updates(map[string]interface{}{
+		"position": position,
+		"version":  gorm.Expr("version + 1"),
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	todo.Position = position
+	todo.Version++
+	return nil
+}
+
+// NOTE: ユーザのTodo(ゴミ箱を含む)の並び順を現在の順序のまま等間隔に振り直す
+func (tr *todoRepository) RebalanceTodoPositions(userId int) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		todos := []models.Todo{}
+		if err := tx.Unscoped().Select("id").Where("user_id = ?", userId).Order("position ASC, id ASC").Find(&todos).Error; err != nil {
+			return err
+		}
+
+		positions := utils.EvenRanks(len(todos))
+		for i, todo := range todos {
+			err := tx.Unscoped().Model(&models.Todo{}).Where("id = ?", todo.ID).Updates(map[string]interface{}{
+				"position": positions[i],
+				"version":  gorm.Expr("version + 1"),
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// NOTE: 並び順が未指定の場合は末尾に追加する
+func (tr *todoRepository) setLastPosition(db *gorm.DB, todo *models.Todo) error {
+	if todo.Position != "" {
+		return nil
+	}
+
+	lastTodos := []models.Todo{}
+	if err := db.Select("position").Where("user_id = ?", todo.UserID).Order("position DESC").Limit(1).Find(&lastTodos).Error; err != nil {
+		return err
+	}
+	lastPosition := ""
+	if len(lastTodos) > 0 {
+		lastPosition = lastTodos[0].Position
+	}
+
+	position, err := utils.RankBetween(lastPosition, "")
+	if err != nil {
+		return err
+	}
+	todo.Position = position
+	return nil
+}
+
+// NOTE: レスポンスで集計する項目のために関連を読み込む
+// NOTE: ブロッカーの状態が変わると依存しているTodoのblockedが変わるため、ETagに反映されるようバージョンを更新する
+func (tr *todoRepository) touchDependents(tx *gorm.DB, blockerId int) error {
+	todoIds := []int{}
+	if err := tx.Model(&models.TodoDependency{}).Where("blocker_id = ?", blockerId).Pluck("todo_id", &todoIds).Error; err != nil {
+		return err
+	}
+	if len(todoIds) == 0 {
+		return nil
+	}
+	return tx.Model(&models.Todo{}).Where("id IN ?", todoIds).Update("version", gorm.Expr("version + 1")).Error
+}
+
+func (tr *todoRepository) preloadAssociations(db *gorm.DB) *gorm.DB {
+	return db.Preload("ChecklistItems", tr.orderChecklistItems).
+		Preload("Dependencies.Blocker").
+		Preload("TodoTags", tr.orderTodoTags)
+}
+
+func (tr *todoRepository) orderChecklistItems(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
+
+func (tr *todoRepository) orderTodoTags(db *gorm.DB) *gorm.DB {
+	return db.Order("name ASC")
+}
@@ -4,9 +4,11 @@ import (
 	"app/models"
 	"app/test/factories"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 var user *models.User
@@ -66,6 +68,45 @@ func (s *TestTodoRePositorySuite) TestGetAllTodos() {
 	assert.Equal(s.T(), 2, len(todos))
 }
 
+func (s *TestTodoRePositorySuite) TestGetTodos() {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.Local)
+	yesterday := now.AddDate(0, 0, -1)
+	tonight := time.Date(2024, 5, 10, 21, 0, 0, 0, time.Local)
+	nextWeek := now.AddDate(0, 0, 7)
+	insertTodos := []models.Todo{
+		{Title: "overdue", Content: "test content", UserID: user.ID, DueAt: &yesterday, Priority: models.TodoPriorityHigh},
+		{Title: "today", Content: "test content", UserID: user.ID, DueAt: &tonight, Priority: models.TodoPriorityLow},
+		{Title: "next week", Content: "test content", UserID: user.ID, DueAt: &nextWeek, Priority: models.TodoPriorityHigh},
+		{Title: "done", Content: "test content", UserID: user.ID, DueAt: &yesterday, Status: models.TodoStatusDone},
+	}
+	if err := DbCon.Create(&insertTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	if err := DbCon.Create(&models.TodoTag{TodoID: insertTodos[2].ID, Name: "work"}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo tag %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	titles := func(filter models.TodoFilter) []string {
+		todos := []models.Todo{}
+		assert.Nil(s.T(), tr.GetTodos(&todos, filter, now, user.ID))
+		result := []string{}
+		for _, todo := range todos {
+			result = append(result, todo.Title)
+		}
+		return result
+	}
+	zero := 0
+	seven := 7
+
+	assert.Equal(s.T(), []string{"overdue"}, titles(models.TodoFilter{Overdue: true}))
+	assert.Equal(s.T(), []string{"today"}, titles(models.TodoFilter{DueFromDays: &zero, DueToDays: &zero}))
+	assert.Equal(s.T(), []string{"today", "next week"}, titles(models.TodoFilter{DueFromDays: &zero, DueToDays: &seven}))
+	assert.Equal(s.T(), []string{"overdue", "next week"}, titles(models.TodoFilter{Priorities: []string{models.TodoPriorityHigh}}))
+	assert.Equal(s.T(), []string{"next week"}, titles(models.TodoFilter{Tags: []string{"work"}}))
+	assert.Equal(s.T(), []string{"done"}, titles(models.TodoFilter{Status: models.TodoStatusDone}))
+}
+
 func (s *TestTodoRePositorySuite) TestGetTodoById() {
 	insertTodo := models.Todo{}
 	insertTodo.Title = "test title 1"
@@ -101,6 +142,188 @@ func (s *TestTodoRePositorySuite) TestUpdateTodo() {
 	assert.Equal(s.T(), "test updated content 1", todo.Content)
 }
 
+func (s *TestTodoRePositorySuite) TestUpdateTodo_VersionConflict() {
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	assert.Equal(s.T(), 1, todo.Version)
+
+	tr := NewTodoRepository(DbCon)
+	// NOTE: 同じバージョンのTodoを2回更新すると、2回目は競合となること
+	staleTodo := todo
+	todo.Title = "test updated title 1"
+	err := tr.UpdateTodo(&todo)
+	staleTodo.Title = "test updated title 2"
+	conflictErr := tr.UpdateTodo(&staleTodo)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, todo.Version)
+	assert.Equal(s.T(), ErrTodoVersionConflict, conflictErr)
+	assert.NotNil(s.T(), tr.DeleteTodo(&staleTodo))
+}
+
+func (s *TestTodoRePositorySuite) TestGetTodosLastModifiedAt() {
+	updatedAt := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID, UpdatedAt: updatedAt.Add(-time.Hour)},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID, UpdatedAt: updatedAt},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	lastModifiedAt := time.Time{}
+	err := tr.GetTodosLastModifiedAt(&lastModifiedAt, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), updatedAt.Equal(lastModifiedAt))
+
+	// NOTE: 削除した場合も最終更新日時が進むこと
+	if err := tr.DeleteTodo(&todos[0]); err != nil {
+		s.T().Fatalf("failed to delete test todo %v", err)
+	}
+	tr.GetTodosLastModifiedAt(&lastModifiedAt, user.ID)
+	assert.True(s.T(), lastModifiedAt.After(updatedAt))
+}
+
+func (s *TestTodoRePositorySuite) TestCreateTodo_Position() {
+	tr := NewTodoRepository(DbCon)
+	firstTodo := models.Todo{Title: "test title 1", UserID: user.ID}
+	secondTodo := models.Todo{Title: "test title 2", UserID: user.ID}
+	tr.CreateTodo(&firstTodo)
+	tr.CreateTodo(&secondTodo)
+
+	// NOTE: 末尾に追加されること
+	assert.NotEqual(s.T(), "", firstTodo.Position)
+	assert.Less(s.T(), firstTodo.Position, secondTodo.Position)
+}
+
+func (s *TestTodoRePositorySuite) TestMoveTodo() {
+	todos := []models.Todo{
+		{Title: "test title 1", UserID: user.ID, Position: "a"},
+		{Title: "test title 2", UserID: user.ID, Position: "b"},
+		{Title: "test title 3", UserID: user.ID, Position: "c"},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	err := tr.MoveTodo(&todos[2], "0i")
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, todos[2].Version)
+	fetchedTodos := []models.Todo{}
+	tr.GetAllTodos(&fetchedTodos, user.ID)
+	assert.Equal(s.T(), []string{"test title 3", "test title 1", "test title 2"}, []string{fetchedTodos[0].Title, fetchedTodos[1].Title, fetchedTodos[2].Title})
+}
+
+func (s *TestTodoRePositorySuite) TestGetTodoByPosition() {
+	todos := []models.Todo{
+		{Title: "test title 1", UserID: user.ID, Position: "a"},
+		{Title: "test title 2", UserID: user.ID, Position: "b"},
+		{Title: "test title 3", UserID: user.ID, Position: "c"},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	previousTodo := models.Todo{}
+	nextTodo := models.Todo{}
+	lastTodo := models.Todo{}
+	tr.GetPreviousTodoByPosition(&previousTodo, "c", todos[1].ID, user.ID)
+	tr.GetNextTodoByPosition(&nextTodo, "a", 0, user.ID)
+	tr.GetNextTodoByPosition(&lastTodo, "c", 0, user.ID)
+
+	// NOTE: 除外したTodoは対象外となり、該当が無い場合はゼロ値となること
+	assert.Equal(s.T(), todos[0].ID, previousTodo.ID)
+	assert.Equal(s.T(), todos[1].ID, nextTodo.ID)
+	assert.Equal(s.T(), 0, lastTodo.ID)
+}
+
+func (s *TestTodoRePositorySuite) TestRebalanceTodoPositions() {
+	todos := []models.Todo{
+		{Title: "test title 1", UserID: user.ID, Position: ""},
+		{Title: "test title 2", UserID: user.ID, Position: "azzzzzzzzzzzzzzzzzzzzzzzzz1"},
+		{Title: "test title 3", UserID: user.ID, Position: "b"},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	err := tr.RebalanceTodoPositions(user.ID)
+
+	assert.Nil(s.T(), err)
+	fetchedTodos := []models.Todo{}
+	tr.GetAllTodos(&fetchedTodos, user.ID)
+	// NOTE: 順序を保ったまま短い並び順に振り直されること
+	for i, fetchedTodo := range fetchedTodos {
+		assert.Equal(s.T(), todos[i].ID, fetchedTodo.ID)
+		assert.NotEqual(s.T(), "", fetchedTodo.Position)
+		assert.LessOrEqual(s.T(), len(fetchedTodo.Position), 2)
+	}
+}
+
+func (s *TestTodoRePositorySuite) TestAssignTodo() {
+	assignee := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "assignee@example.com"}).(*models.User)
+	if err := DbCon.Create(&assignee).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	err := tr.AssignTodo(&todo, &assignee.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), assignee.ID, *todo.AssigneeID)
+	// NOTE: 担当者は参照できるが、作成者としては取得できないこと
+	assignedTodos := []models.Todo{}
+	tr.GetAssignedTodos(&assignedTodos, assignee.ID)
+	assert.Len(s.T(), assignedTodos, 1)
+	assert.NotNil(s.T(), tr.GetTodoById(&models.Todo{}, todo.ID, assignee.ID))
+
+	// NOTE: 担当者を外すと担当一覧に含まれなくなること
+	tr.AssignTodo(&todo, nil)
+	assignedTodos = []models.Todo{}
+	tr.GetAssignedTodos(&assignedTodos, assignee.ID)
+	assert.Len(s.T(), assignedTodos, 0)
+}
+
+func (s *TestTodoRePositorySuite) TestGetSharedTodos() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todos[1].ID, UserID: collaborator.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	sharedTodos := []models.Todo{}
+	err := tr.GetSharedTodos(&sharedTodos, collaborator.ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 共有されたTodoのみ取得できること
+	assert.Len(s.T(), sharedTodos, 1)
+	assert.Equal(s.T(), todos[1].ID, sharedTodos[0].ID)
+	// NOTE: 作成者を問わずIDで取得できること
+	assert.Nil(s.T(), tr.FindTodoById(&models.Todo{}, todos[1].ID))
+}
+
 func (s *TestTodoRePositorySuite) TestDeleteTodo() {
 	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
 	if err := DbCon.Create(&todo).Error; err != nil {
@@ -119,6 +342,218 @@ func (s *TestTodoRePositorySuite) TestDeleteTodo() {
 	assert.Equal(s.T(), 0, len(todos))
 }
 
+func (s *TestTodoRePositorySuite) TestCompleteTodo() {
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	items := []models.ChecklistItem{
+		{TodoID: todo.ID, Title: "test item 1", Position: 1},
+		{TodoID: todo.ID, Title: "test item 2", Position: 2},
+	}
+	if err := DbCon.Create(&items).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist items %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	err := tr.CompleteTodo(&todo, true, nil)
+
+	assert.Nil(s.T(), err)
+	completedTodo := models.Todo{}
+	tr.GetTodoById(&completedTodo, todo.ID, user.ID)
+	assert.Equal(s.T(), models.TodoStatusDone, completedTodo.Status)
+	assert.NotNil(s.T(), completedTodo.CompletedAt)
+	assert.Equal(s.T(), models.ChecklistProgress{Done: 2, Total: 2}, completedTodo.ChecklistProgress)
+}
+
+func (s *TestTodoRePositorySuite) TestCompleteTodo_WithoutChecklistItems() {
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	err := tr.CompleteTodo(&todo, false, nil)
+
+	assert.Nil(s.T(), err)
+	completedTodo := models.Todo{}
+	tr.GetTodoById(&completedTodo, todo.ID, user.ID)
+	assert.Equal(s.T(), models.TodoStatusDone, completedTodo.Status)
+	assert.Equal(s.T(), models.ChecklistProgress{Done: 0, Total: 1}, completedTodo.ChecklistProgress)
+}
+
+func (s *TestTodoRePositorySuite) TestCompleteTodo_WithNextTodo() {
+	dueAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", DueAt: &dueAt, RecurrenceRule: "FREQ=DAILY", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	nextDueAt := dueAt.AddDate(0, 0, 1)
+	nextTodo := models.Todo{Title: "test title 1", Content: "test content 1", DueAt: &nextDueAt, RecurrenceRule: "FREQ=DAILY", UserID: user.ID}
+	err := tr.CompleteTodo(&todo, false, &nextTodo)
+
+	assert.Nil(s.T(), err)
+	assert.NotEqual(s.T(), 0, nextTodo.ID)
+	todos := []models.Todo{}
+	tr.GetAllTodos(&todos, user.ID)
+	assert.Equal(s.T(), 2, len(todos))
+}
+
+func (s *TestTodoRePositorySuite) TestReopenTodo() {
+	completedAt := time.Now()
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, CompletedAt: &completedAt, ArchivedAt: &completedAt, UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	err := tr.ReopenTodo(&todo)
+
+	assert.Nil(s.T(), err)
+	reopenedTodo := models.Todo{}
+	tr.GetTodoById(&reopenedTodo, todo.ID, user.ID)
+	assert.Equal(s.T(), models.TodoStatusTodo, reopenedTodo.Status)
+	assert.Nil(s.T(), reopenedTodo.CompletedAt)
+	assert.Nil(s.T(), reopenedTodo.ArchivedAt)
+}
+
+func (s *TestTodoRePositorySuite) TestArchiveTodo() {
+	completedAt := time.Now()
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, CompletedAt: &completedAt, UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	err := tr.ArchiveTodo(&todo)
+
+	// NOTE: 既定の一覧からは除外され、include_archivedを指定した場合のみ取得できること
+	assert.Nil(s.T(), err)
+	todos := []models.Todo{}
+	tr.GetTodos(&todos, models.TodoFilter{}, time.Now(), user.ID)
+	assert.Len(s.T(), todos, 0)
+	tr.GetTodos(&todos, models.TodoFilter{IncludeArchived: true}, time.Now(), user.ID)
+	assert.Len(s.T(), todos, 1)
+	assert.NotNil(s.T(), todos[0].ArchivedAt)
+
+	assert.Nil(s.T(), tr.UnarchiveTodo(&todo))
+	tr.GetTodos(&todos, models.TodoFilter{}, time.Now(), user.ID)
+	assert.Len(s.T(), todos, 1)
+}
+
+func (s *TestTodoRePositorySuite) TestArchiveTodosCompletedBefore() {
+	oldCompletedAt := time.Now().AddDate(0, 0, -10)
+	recentCompletedAt := time.Now().AddDate(0, 0, -1)
+	otherUser := models.User{Name: "other", Email: "other@example.com", Password: "password"}
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, CompletedAt: &oldCompletedAt, UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", Status: models.TodoStatusDone, CompletedAt: &recentCompletedAt, UserID: user.ID},
+		{Title: "test title 3", Content: "test content 3", Status: models.TodoStatusTodo, UserID: user.ID},
+		{Title: "test title 4", Content: "test content 4", Status: models.TodoStatusDone, CompletedAt: &oldCompletedAt, UserID: otherUser.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	archivedCount, err := tr.ArchiveTodosCompletedBefore(user.ID, time.Now().AddDate(0, 0, -7))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(1), archivedCount)
+	fetchedTodos := []models.Todo{}
+	DbCon.Where("archived_at IS NOT NULL").Find(&fetchedTodos)
+	assert.Len(s.T(), fetchedTodos, 1)
+	assert.Equal(s.T(), todos[0].ID, fetchedTodos[0].ID)
+}
+
+func (s *TestTodoRePositorySuite) TestGetTrashedTodos() {
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	DbCon.Delete(&todos[0])
+
+	trashedTodos := []models.Todo{}
+	tr := NewTodoRepository(DbCon)
+	err := tr.GetTrashedTodos(&trashedTodos, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(trashedTodos))
+	assert.Equal(s.T(), todos[0].ID, trashedTodos[0].ID)
+}
+
+func (s *TestTodoRePositorySuite) TestRestoreTodo() {
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	DbCon.Delete(&todo)
+
+	trashedTodo := models.Todo{}
+	tr := NewTodoRepository(DbCon)
+	tr.GetTrashedTodoById(&trashedTodo, todo.ID, user.ID)
+	err := tr.RestoreTodo(&trashedTodo)
+
+	assert.Nil(s.T(), err)
+	restoredTodo := models.Todo{}
+	assert.Nil(s.T(), tr.GetTodoById(&restoredTodo, todo.ID, user.ID))
+}
+
+func (s *TestTodoRePositorySuite) TestPurgeTodo() {
+	todo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+	DbCon.Delete(&todo)
+
+	tr := NewTodoRepository(DbCon)
+	err := tr.PurgeTodo(&todo)
+
+	assert.Nil(s.T(), err)
+	var count int64
+	DbCon.Unscoped().Model(&models.Todo{}).Where("id = ?", todo.ID).Count(&count)
+	assert.Equal(s.T(), int64(0), count)
+	DbCon.Model(&models.ChecklistItem{}).Where("todo_id = ?", todo.ID).Count(&count)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestTodoRePositorySuite) TestPurgeTodosDeletedBefore() {
+	now := time.Now()
+	todos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID, DeletedAt: gorm.DeletedAt{Time: now.AddDate(0, 0, -40), Valid: true}},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID, DeletedAt: gorm.DeletedAt{Time: now.AddDate(0, 0, -10), Valid: true}},
+		{Title: "test title 3", Content: "test content 3", UserID: user.ID},
+	}
+	if err := DbCon.Create(&todos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	tr := NewTodoRepository(DbCon)
+	purgedCount, err := tr.PurgeTodosDeletedBefore(now.AddDate(0, 0, -30))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(1), purgedCount)
+	var count int64
+	DbCon.Unscoped().Model(&models.Todo{}).Where("user_id = ?", user.ID).Count(&count)
+	assert.Equal(s.T(), int64(2), count)
+}
+
 func TestTodoRepository(t *testing.T) {
 	// テストスイートを実施
 	suite.Run(t, new(TestTodoRePositorySuite))
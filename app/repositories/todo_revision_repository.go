@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type TodoRevisionRepository interface {
+	CreateTodoRevision(revision *models.TodoRevision) error
+	GetTodoRevisions(revisions *[]models.TodoRevision, todoId int) error
+	GetTodoRevision(revision *models.TodoRevision, todoId int, revisionNumber int) error
+}
+
+type todoRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewTodoRevisionRepository(db *gorm.DB) TodoRevisionRepository {
+	return &todoRevisionRepository{db}
+}
+
+func (trr *todoRevisionRepository) CreateTodoRevision(revision *models.TodoRevision) error {
+	// NOTE: Todoごとに連番を振る
+	var maxRevision int
+	err := trr.db.Model(&models.TodoRevision{}).Where("todo_id = ?", revision.TodoID).Select("COALESCE(MAX(revision), 0)").Scan(&maxRevision).Error
+	if err != nil {
+		return err
+	}
+	revision.Revision = maxRevision + 1
+
+	if err := trr.db.Create(&revision).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (trr *todoRevisionRepository) GetTodoRevisions(revisions *[]models.TodoRevision, todoId int) error {
+	if err := trr.db.Where("todo_id = ?", todoId).Order("revision DESC").Find(&revisions).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (trr *todoRevisionRepository) GetTodoRevision(revision *models.TodoRevision, todoId int, revisionNumber int) error {
+	if err := trr.db.Where("todo_id = ? AND revision = ?", todoId, revisionNumber).First(&revision).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoRevisionRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoRevisionRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestTodoRevisionRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoRevisionRePositorySuite) TestCreateTodoRevision() {
+	trr := NewTodoRevisionRepository(DbCon)
+	firstRevision := models.TodoRevision{
+		TodoID:   todo.ID,
+		UserID:   user.ID,
+		Action:   models.TodoRevisionActionCreate,
+		Changes:  map[string]models.TodoFieldChange{"title": {Old: "", New: "test title 1"}},
+		Snapshot: models.TodoSnapshot{Title: "test title 1"},
+	}
+	secondRevision := models.TodoRevision{
+		TodoID:   todo.ID,
+		UserID:   user.ID,
+		Action:   models.TodoRevisionActionUpdate,
+		Changes:  map[string]models.TodoFieldChange{"title": {Old: "test title 1", New: "test title 2"}},
+		Snapshot: models.TodoSnapshot{Title: "test title 2"},
+	}
+	firstErr := trr.CreateTodoRevision(&firstRevision)
+	secondErr := trr.CreateTodoRevision(&secondRevision)
+
+	assert.Nil(s.T(), firstErr)
+	assert.Nil(s.T(), secondErr)
+	// NOTE: Todoごとに連番が振られていること
+	assert.Equal(s.T(), 1, firstRevision.Revision)
+	assert.Equal(s.T(), 2, secondRevision.Revision)
+}
+
+func (s *TestTodoRevisionRePositorySuite) TestGetTodoRevisions() {
+	revisions := []models.TodoRevision{
+		{TodoID: todo.ID, Revision: 1, UserID: user.ID, Action: models.TodoRevisionActionCreate, Snapshot: models.TodoSnapshot{Title: "test title 1"}},
+		{TodoID: todo.ID, Revision: 2, UserID: user.ID, Action: models.TodoRevisionActionUpdate, Snapshot: models.TodoSnapshot{Title: "test title 2"}},
+	}
+	if err := DbCon.Create(&revisions).Error; err != nil {
+		s.T().Fatalf("failed to create test revisions %v", err)
+	}
+
+	fetchedRevisions := []models.TodoRevision{}
+	trr := NewTodoRevisionRepository(DbCon)
+	err := trr.GetTodoRevisions(&fetchedRevisions, todo.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(fetchedRevisions))
+	// NOTE: 新しい順に取得されること
+	assert.Equal(s.T(), 2, fetchedRevisions[0].Revision)
+	assert.Equal(s.T(), "test title 2", fetchedRevisions[0].Snapshot.Title)
+}
+
+func (s *TestTodoRevisionRePositorySuite) TestGetTodoRevision() {
+	revision := models.TodoRevision{TodoID: todo.ID, Revision: 1, UserID: user.ID, Action: models.TodoRevisionActionCreate, Snapshot: models.TodoSnapshot{Title: "test title 1"}}
+	if err := DbCon.Create(&revision).Error; err != nil {
+		s.T().Fatalf("failed to create test revision %v", err)
+	}
+
+	fetchedRevision := models.TodoRevision{}
+	trr := NewTodoRevisionRepository(DbCon)
+	err := trr.GetTodoRevision(&fetchedRevision, todo.ID, 1)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), revision.ID, fetchedRevision.ID)
+	assert.NotNil(s.T(), trr.GetTodoRevision(&models.TodoRevision{}, todo.ID, 2))
+}
+
+func TestTodoRevisionRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoRevisionRePositorySuite))
+}
@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type TodoShareLinkRepository interface {
+	CreateTodoShareLink(link *models.TodoShareLink) error
+	GetTodoShareLinks(links *[]models.TodoShareLink, todoId int) error
+	GetTodoShareLinkById(link *models.TodoShareLink, id int, todoId int) error
+	GetTodoShareLinkByToken(link *models.TodoShareLink, token string) error
+	DeleteTodoShareLink(link *models.TodoShareLink) error
+}
+
+type todoShareLinkRepository struct {
+	db *gorm.DB
+}
+
+func NewTodoShareLinkRepository(db *gorm.DB) TodoShareLinkRepository {
+	return &todoShareLinkRepository{db}
+}
+
+func (tslr *todoShareLinkRepository) CreateTodoShareLink(link *models.TodoShareLink) error {
+	if err := tslr.db.Create(&link).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tslr *todoShareLinkRepository) GetTodoShareLinks(links *[]models.TodoShareLink, todoId int) error {
+	if err := tslr.db.Where("todo_id = ?", todoId).Order("id ASC").Find(&links).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tslr *todoShareLinkRepository) GetTodoShareLinkById(link *models.TodoShareLink, id int, todoId int) error {
+	if err := tslr.db.Where("todo_id = ?", todoId).First(&link, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tslr *todoShareLinkRepository) GetTodoShareLinkByToken(link *models.TodoShareLink, token string) error {
+	if err := tslr.db.Where("token = ?", token).First(&link).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tslr *todoShareLinkRepository) DeleteTodoShareLink(link *models.TodoShareLink) error {
+	if err := tslr.db.Delete(&link).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
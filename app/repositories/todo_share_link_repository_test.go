@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoShareLinkRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoShareLinkRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestTodoShareLinkRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoShareLinkRePositorySuite) TestCreateTodoShareLink() {
+	tslr := NewTodoShareLinkRepository(DbCon)
+	link := models.TodoShareLink{TodoID: todo.ID, Token: "test-token-1", UserID: user.ID}
+	err := tslr.CreateTodoShareLink(&link)
+
+	assert.Nil(s.T(), err)
+	assert.NotEqual(s.T(), 0, link.ID)
+	links := []models.TodoShareLink{}
+	tslr.GetTodoShareLinks(&links, todo.ID)
+	assert.Len(s.T(), links, 1)
+}
+
+func (s *TestTodoShareLinkRePositorySuite) TestGetTodoShareLinkByToken() {
+	insertLink := models.TodoShareLink{TodoID: todo.ID, Token: "test-token-1", PasswordHash: "hash", UserID: user.ID}
+	if err := DbCon.Create(&insertLink).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share link %v", err)
+	}
+
+	tslr := NewTodoShareLinkRepository(DbCon)
+	link := models.TodoShareLink{}
+	err := tslr.GetTodoShareLinkByToken(&link, "test-token-1")
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), insertLink.ID, link.ID)
+	// NOTE: パスワードの有無が設定されていること
+	assert.True(s.T(), link.PasswordProtected)
+	assert.NotNil(s.T(), tslr.GetTodoShareLinkByToken(&models.TodoShareLink{}, "unknown-token"))
+}
+
+func (s *TestTodoShareLinkRePositorySuite) TestDeleteTodoShareLink() {
+	link := models.TodoShareLink{TodoID: todo.ID, Token: "test-token-1", UserID: user.ID}
+	if err := DbCon.Create(&link).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share link %v", err)
+	}
+
+	tslr := NewTodoShareLinkRepository(DbCon)
+	err := tslr.DeleteTodoShareLink(&link)
+
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), tslr.GetTodoShareLinkById(&models.TodoShareLink{}, link.ID, todo.ID))
+}
+
+func TestTodoShareLinkRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoShareLinkRePositorySuite))
+}
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type TodoShareRepository interface {
+	SaveTodoShare(share *models.TodoShare) error
+	GetTodoShares(shares *[]models.TodoShare, todoId int) error
+	GetTodoShare(share *models.TodoShare, todoId int, userId int) error
+	DeleteTodoShare(share *models.TodoShare) error
+}
+
+type todoShareRepository struct {
+	db *gorm.DB
+}
+
+func NewTodoShareRepository(db *gorm.DB) TodoShareRepository {
+	return &todoShareRepository{db}
+}
+
+// NOTE: 既に共有済みのユーザの場合は権限のみ更新する
+func (tsr *todoShareRepository) SaveTodoShare(share *models.TodoShare) error {
+	role := share.Role
+	err := tsr.db.Where(models.TodoShare{TodoID: share.TodoID, UserID: share.UserID}).
+		Attrs(models.TodoShare{Role: role}).
+		FirstOrCreate(&share).Error
+	if err != nil {
+		return err
+	}
+	if share.Role == role {
+		return nil
+	}
+
+	if err := tsr.db.Model(&share).Update("role", role).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tsr *todoShareRepository) GetTodoShares(shares *[]models.TodoShare, todoId int) error {
+	if err := tsr.db.Where("todo_id = ?", todoId).Order("id ASC").Find(&shares).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tsr *todoShareRepository) GetTodoShare(share *models.TodoShare, todoId int, userId int) error {
+	if err := tsr.db.Where("todo_id = ? AND user_id = ?", todoId, userId).First(&share).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tsr *todoShareRepository) DeleteTodoShare(share *models.TodoShare) error {
+	if err := tsr.db.Delete(&share).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
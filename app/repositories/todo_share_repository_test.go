@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+var collaborator *models.User
+
+type TestTodoShareRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoShareRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・共同作業者・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	collaborator = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+}
+
+func (s *TestTodoShareRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoShareRePositorySuite) TestSaveTodoShare() {
+	tsr := NewTodoShareRepository(DbCon)
+	share := models.TodoShare{TodoID: todo.ID, UserID: collaborator.ID, Role: models.TodoShareRoleViewer}
+	err := tsr.SaveTodoShare(&share)
+
+	assert.Nil(s.T(), err)
+	assert.NotEqual(s.T(), 0, share.ID)
+
+	// NOTE: 同じユーザに再度共有した場合は権限が更新されること
+	updatedShare := models.TodoShare{TodoID: todo.ID, UserID: collaborator.ID, Role: models.TodoShareRoleEditor}
+	updateErr := tsr.SaveTodoShare(&updatedShare)
+
+	assert.Nil(s.T(), updateErr)
+	assert.Equal(s.T(), share.ID, updatedShare.ID)
+	shares := []models.TodoShare{}
+	tsr.GetTodoShares(&shares, todo.ID)
+	assert.Len(s.T(), shares, 1)
+	assert.Equal(s.T(), models.TodoShareRoleEditor, shares[0].Role)
+}
+
+func (s *TestTodoShareRePositorySuite) TestGetTodoShare() {
+	insertShare := models.TodoShare{TodoID: todo.ID, UserID: collaborator.ID, Role: models.TodoShareRoleViewer}
+	if err := DbCon.Create(&insertShare).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	tsr := NewTodoShareRepository(DbCon)
+	share := models.TodoShare{}
+	err := tsr.GetTodoShare(&share, todo.ID, collaborator.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), insertShare.ID, share.ID)
+	assert.NotNil(s.T(), tsr.GetTodoShare(&models.TodoShare{}, todo.ID, user.ID))
+}
+
+func (s *TestTodoShareRePositorySuite) TestDeleteTodoShare() {
+	share := models.TodoShare{TodoID: todo.ID, UserID: collaborator.ID, Role: models.TodoShareRoleViewer}
+	if err := DbCon.Create(&share).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	tsr := NewTodoShareRepository(DbCon)
+	err := tsr.DeleteTodoShare(&share)
+
+	assert.Nil(s.T(), err)
+	shares := []models.TodoShare{}
+	tsr.GetTodoShares(&shares, todo.ID)
+	assert.Len(s.T(), shares, 0)
+}
+
+func TestTodoShareRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoShareRePositorySuite))
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type TodoTemplateRepository interface {
+	CreateTodoTemplate(template *models.TodoTemplate) error
+	GetTodoTemplates(templates *[]models.TodoTemplate, userId int) error
+	GetTodoTemplateById(template *models.TodoTemplate, id int, userId int) error
+	UpdateTodoTemplate(template *models.TodoTemplate) error
+	DeleteTodoTemplate(template *models.TodoTemplate) error
+}
+
+type todoTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewTodoTemplateRepository(db *gorm.DB) TodoTemplateRepository {
+	return &todoTemplateRepository{db}
+}
+
+func (ttr *todoTemplateRepository) CreateTodoTemplate(template *models.TodoTemplate) error {
+	if err := ttr.db.Create(&template).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ttr *todoTemplateRepository) GetTodoTemplates(templates *[]models.TodoTemplate, userId int) error {
+	if err := ttr.db.Where("user_id = ?", userId).Order("id ASC").Find(&templates).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ttr *todoTemplateRepository) GetTodoTemplateById(template *models.TodoTemplate, id int, userId int) error {
+	if err := ttr.db.Where("user_id = ?", userId).First(&template, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: チェックリスト・タグはシリアライザを通すため、mapではなく構造体で更新する
+func (ttr *todoTemplateRepository) UpdateTodoTemplate(template *models.TodoTemplate) error {
+	if err := ttr.db.Model(&template).Select("Name", "Title", "Content", "Priority", "ChecklistItems", "Tags", "DueOffsetDays").Updates(template).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ttr *todoTemplateRepository) DeleteTodoTemplate(template *models.TodoTemplate) error {
+	if err := ttr.db.Delete(&template).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoTemplateRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoTemplateRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+}
+
+func (s *TestTodoTemplateRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoTemplateRePositorySuite) TestCreateTodoTemplate() {
+	ttr := NewTodoTemplateRepository(DbCon)
+	dueOffsetDays := 7
+	template := models.TodoTemplate{UserID: user.ID, Name: "onboarding", Title: "Onboard {{name}}", ChecklistItems: []string{"Create account", "Send welcome mail"}, Tags: []string{"hr"}, DueOffsetDays: &dueOffsetDays}
+	err := ttr.CreateTodoTemplate(&template)
+
+	assert.Nil(s.T(), err)
+	fetchedTemplate := models.TodoTemplate{}
+	assert.Nil(s.T(), ttr.GetTodoTemplateById(&fetchedTemplate, template.ID, user.ID))
+	assert.Equal(s.T(), []string{"Create account", "Send welcome mail"}, fetchedTemplate.ChecklistItems)
+	assert.Equal(s.T(), []string{"hr"}, fetchedTemplate.Tags)
+	assert.Equal(s.T(), 7, *fetchedTemplate.DueOffsetDays)
+}
+
+func (s *TestTodoTemplateRePositorySuite) TestUpdateTodoTemplate() {
+	dueOffsetDays := 7
+	template := models.TodoTemplate{UserID: user.ID, Name: "onboarding", Title: "Onboard {{name}}", ChecklistItems: []string{"Create account"}, Tags: []string{"hr"}, DueOffsetDays: &dueOffsetDays}
+	if err := DbCon.Create(&template).Error; err != nil {
+		s.T().Fatalf("failed to create test template %v", err)
+	}
+
+	ttr := NewTodoTemplateRepository(DbCon)
+	template.ChecklistItems = []string{}
+	template.Tags = []string{}
+	template.DueOffsetDays = nil
+	err := ttr.UpdateTodoTemplate(&template)
+
+	assert.Nil(s.T(), err)
+	fetchedTemplate := models.TodoTemplate{}
+	assert.Nil(s.T(), ttr.GetTodoTemplateById(&fetchedTemplate, template.ID, user.ID))
+	assert.Empty(s.T(), fetchedTemplate.ChecklistItems)
+	assert.Empty(s.T(), fetchedTemplate.Tags)
+	assert.Nil(s.T(), fetchedTemplate.DueOffsetDays)
+}
+
+func (s *TestTodoTemplateRePositorySuite) TestGetTodoTemplateById_OtherUser() {
+	other := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := DbCon.Create(&other).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	template := models.TodoTemplate{UserID: other.ID, Name: "onboarding", Title: "Onboard {{name}}"}
+	if err := DbCon.Create(&template).Error; err != nil {
+		s.T().Fatalf("failed to create test template %v", err)
+	}
+
+	fetchedTemplate := models.TodoTemplate{}
+	ttr := NewTodoTemplateRepository(DbCon)
+	err := ttr.GetTodoTemplateById(&fetchedTemplate, template.ID, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func TestTodoTemplateRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoTemplateRePositorySuite))
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type TodoViewRepository interface {
+	CreateTodoView(view *models.TodoView) error
+	GetTodoViews(views *[]models.TodoView, userId int) error
+	GetTodoViewById(view *models.TodoView, id int, userId int) error
+	UpdateTodoView(view *models.TodoView) error
+	DeleteTodoView(view *models.TodoView) error
+}
+
+type todoViewRepository struct {
+	db *gorm.DB
+}
+
+func NewTodoViewRepository(db *gorm.DB) TodoViewRepository {
+	return &todoViewRepository{db}
+}
+
+func (tvr *todoViewRepository) CreateTodoView(view *models.TodoView) error {
+	if err := tvr.db.Create(&view).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tvr *todoViewRepository) GetTodoViews(views *[]models.TodoView, userId int) error {
+	if err := tvr.db.Where("user_id = ?", userId).Order("id ASC").Find(&views).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tvr *todoViewRepository) GetTodoViewById(view *models.TodoView, id int, userId int) error {
+	if err := tvr.db.Where("user_id = ?", userId).First(&view, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 絞り込み条件はシリアライザを通すため、mapではなく構造体で更新する
+func (tvr *todoViewRepository) UpdateTodoView(view *models.TodoView) error {
+	if err := tvr.db.Model(&view).Select("Name", "Filter").Updates(view).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tvr *todoViewRepository) DeleteTodoView(view *models.TodoView) error {
+	if err := tvr.db.Delete(&view).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
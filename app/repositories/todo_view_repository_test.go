@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoViewRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestTodoViewRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+}
+
+func (s *TestTodoViewRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoViewRePositorySuite) TestCreateTodoView() {
+	tvr := NewTodoViewRepository(DbCon)
+	view := models.TodoView{UserID: user.ID, Name: "high priority", Filter: models.TodoFilter{Priorities: []string{models.TodoPriorityHigh}}}
+	err := tvr.CreateTodoView(&view)
+
+	assert.Nil(s.T(), err)
+	fetchedView := models.TodoView{}
+	assert.Nil(s.T(), tvr.GetTodoViewById(&fetchedView, view.ID, user.ID))
+	assert.Equal(s.T(), []string{models.TodoPriorityHigh}, fetchedView.Filter.Priorities)
+}
+
+func (s *TestTodoViewRePositorySuite) TestUpdateTodoView() {
+	view := models.TodoView{UserID: user.ID, Name: "high priority", Filter: models.TodoFilter{Priorities: []string{models.TodoPriorityHigh}}}
+	if err := DbCon.Create(&view).Error; err != nil {
+		s.T().Fatalf("failed to create test view %v", err)
+	}
+
+	tvr := NewTodoViewRepository(DbCon)
+	view.Name = "work"
+	view.Filter = models.TodoFilter{Tags: []string{"work"}}
+	err := tvr.UpdateTodoView(&view)
+
+	assert.Nil(s.T(), err)
+	fetchedView := models.TodoView{}
+	assert.Nil(s.T(), tvr.GetTodoViewById(&fetchedView, view.ID, user.ID))
+	assert.Equal(s.T(), "work", fetchedView.Name)
+	assert.Equal(s.T(), models.TodoFilter{Tags: []string{"work"}}, fetchedView.Filter)
+}
+
+func (s *TestTodoViewRePositorySuite) TestGetTodoViews_OnlyOwn() {
+	other := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := DbCon.Create(&other).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	views := []models.TodoView{{UserID: user.ID, Name: "mine"}, {UserID: other.ID, Name: "others"}}
+	if err := DbCon.Create(&views).Error; err != nil {
+		s.T().Fatalf("failed to create test views %v", err)
+	}
+
+	fetchedViews := []models.TodoView{}
+	tvr := NewTodoViewRepository(DbCon)
+	err := tvr.GetTodoViews(&fetchedViews, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), fetchedViews, 1)
+	assert.Equal(s.T(), "mine", fetchedViews[0].Name)
+}
+
+func TestTodoViewRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoViewRePositorySuite))
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import "gorm.io/gorm"
+
+// NOTE: 複数の処理を1つのトランザクションで実行するため、トランザクションに紐づいたリポジトリを提供する
+type TransactionRepository interface {
+	Transaction(fc func(tx TransactionRepository) error) error
+	TodoRepository() TodoRepository
+	TodoRevisionRepository() TodoRevisionRepository
+	UserRepository() UserRepository
+	TodoShareRepository() TodoShareRepository
+	TagRepository() TagRepository
+	NotificationRepository() NotificationRepository
+	UserSettingRepository() UserSettingRepository
+}
+
+type transactionRepository struct {
+	db *gorm.DB
+}
+
+func NewTransactionRepository(db *gorm.DB) TransactionRepository {
+	return &transactionRepository{db}
+}
+
+// NOTE: トランザクション内で呼び出した場合はセーブポイントとして扱われる
+func (tr *transactionRepository) Transaction(fc func(tx TransactionRepository) error) error {
+	return tr.db.Transaction(func(tx *gorm.DB) error {
+		return fc(&transactionRepository{tx})
+	})
+}
+
+func (tr *transactionRepository) TodoRepository() TodoRepository {
+	return NewTodoRepository(tr.db)
+}
+
+func (tr *transactionRepository) TodoRevisionRepository() TodoRevisionRepository {
+	return NewTodoRevisionRepository(tr.db)
+}
+
+func (tr *transactionRepository) UserRepository() UserRepository {
+	return NewUserRepository(tr.db)
+}
+
+func (tr *transactionRepository) TodoShareRepository() TodoShareRepository {
+	return NewTodoShareRepository(tr.db)
+}
+
+func (tr *transactionRepository) TagRepository() TagRepository {
+	return NewTagRepository(tr.db)
+}
+
+func (tr *transactionRepository) NotificationRepository() NotificationRepository {
+	return NewNotificationRepository(tr.db)
+}
+
+func (tr *transactionRepository) UserSettingRepository() UserSettingRepository {
+	return NewUserSettingRepository(tr.db)
+}
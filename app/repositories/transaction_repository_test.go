@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTransactionRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestTransactionRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+}
+
+func (s *TestTransactionRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTransactionRePositorySuite) TestTransaction() {
+	tr := NewTransactionRepository(DbCon)
+	err := tr.Transaction(func(tx TransactionRepository) error {
+		if err := tx.TodoRepository().CreateTodo(&models.Todo{Title: "test title 1", UserID: user.ID}); err != nil {
+			return err
+		}
+		// NOTE: セーブポイントで失敗した処理のみ取り消されること
+		_ = tx.Transaction(func(savepoint TransactionRepository) error {
+			if err := savepoint.TodoRepository().CreateTodo(&models.Todo{Title: "test title 2", UserID: user.ID}); err != nil {
+				return err
+			}
+			return errors.New("rollback savepoint")
+		})
+		return nil
+	})
+
+	assert.Nil(s.T(), err)
+	todos := []models.Todo{}
+	DbCon.Where("user_id = ?", user.ID).Find(&todos)
+	assert.Len(s.T(), todos, 1)
+	assert.Equal(s.T(), "test title 1", todos[0].Title)
+}
+
+func (s *TestTransactionRePositorySuite) TestTransaction_Rollback() {
+	tr := NewTransactionRepository(DbCon)
+	err := tr.Transaction(func(tx TransactionRepository) error {
+		if err := tx.TodoRepository().CreateTodo(&models.Todo{Title: "test title 1", UserID: user.ID}); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+
+	assert.NotNil(s.T(), err)
+	todos := []models.Todo{}
+	DbCon.Where("user_id = ?", user.ID).Find(&todos)
+	assert.Len(s.T(), todos, 0)
+}
+
+func TestTransactionRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTransactionRePositorySuite))
+}
@@ -11,6 +11,7 @@ type UserRepository interface {
 	CreateUser(user *models.User) error
 	FindUserByEmail(user *models.User, email string) error
 	FindUserById(id int) models.User
+	GetUserById(user *models.User, id int) error
 }
 
 type userRepository struct {
@@ -42,3 +43,10 @@ func (ur *userRepository) FindUserById(id int) models.User {
 	}
 	return user
 }
+
+func (ur *userRepository) GetUserById(user *models.User, id int) error {
+	if err := ur.db.First(&user, id).Error; err != nil {
+		return err
+	}
+	return nil
+}
@@ -63,6 +63,21 @@ func (s *TestUserRePositorySuite) TestFindUserById() {
 	assert.Equal(s.T(), testUser.Name, user.Name)
 }
 
+func (s *TestUserRePositorySuite) TestGetUserById() {
+	testUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&testUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	ur := NewUserRepository(DbCon)
+	user := models.User{}
+	err := ur.GetUserById(&user, testUser.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), testUser.Name, user.Name)
+	assert.NotNil(s.T(), ur.GetUserById(&models.User{}, testUser.ID+1))
+}
+
 func TestUserRepository(t *testing.T) {
 	// テストスイートを実行
 	suite.Run(t, new(TestUserRePositorySuite))
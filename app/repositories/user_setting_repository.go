@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"app/models"
+
+	"gorm.io/gorm"
+)
+
+type UserSettingRepository interface {
+	GetUserSetting(setting *models.UserSetting, userId int) error
+	SaveUserSetting(setting *models.UserSetting) error
+	GetAutoArchiveUserSettings(settings *[]models.UserSetting) error
+}
+
+type userSettingRepository struct {
+	db *gorm.DB
+}
+
+func NewUserSettingRepository(db *gorm.DB) UserSettingRepository {
+	return &userSettingRepository{db}
+}
+
+func (usr *userSettingRepository) GetUserSetting(setting *models.UserSetting, userId int) error {
+	if err := usr.db.Where("user_id = ?", userId).First(&setting).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NOTE: 未作成の場合は作成し、作成済みの場合は全項目を更新する
+func (usr *userSettingRepository) SaveUserSetting(setting *models.UserSetting) error {
+	if err := usr.db.Omit("User").Save(&setting).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (usr *userSettingRepository) GetAutoArchiveUserSettings(settings *[]models.UserSetting) error {
+	if err := usr.db.Where("auto_archive_days IS NOT NULL").Order("user_id ASC").Find(&settings).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
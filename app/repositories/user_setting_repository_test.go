@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"app/models"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestUserSettingRePositorySuite struct {
+	WithDbSuite
+}
+
+func (s *TestUserSettingRePositorySuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+}
+
+func (s *TestUserSettingRePositorySuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestUserSettingRePositorySuite) TestSaveUserSetting() {
+	usr := NewUserSettingRepository(DbCon)
+	autoArchiveDays := 7
+	setting := models.UserSetting{UserID: user.ID, AutoArchiveDays: &autoArchiveDays}
+	assert.Nil(s.T(), usr.SaveUserSetting(&setting))
+
+	// NOTE: 作成済みの設定はnilで自動アーカイブを無効にできること
+	setting.AutoArchiveDays = nil
+	err := usr.SaveUserSetting(&setting)
+
+	assert.Nil(s.T(), err)
+	fetchedSetting := models.UserSetting{}
+	assert.Nil(s.T(), usr.GetUserSetting(&fetchedSetting, user.ID))
+	assert.Equal(s.T(), setting.ID, fetchedSetting.ID)
+	assert.Nil(s.T(), fetchedSetting.AutoArchiveDays)
+}
+
+func (s *TestUserSettingRePositorySuite) TestGetAutoArchiveUserSettings() {
+	otherUser := models.User{Name: "other", Email: "other@example.com", Password: "password"}
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	autoArchiveDays := 7
+	settings := []models.UserSetting{{UserID: user.ID, AutoArchiveDays: &autoArchiveDays}, {UserID: otherUser.ID}}
+	if err := DbCon.Create(&settings).Error; err != nil {
+		s.T().Fatalf("failed to create test settings %v", err)
+	}
+
+	usr := NewUserSettingRepository(DbCon)
+	fetchedSettings := []models.UserSetting{}
+	err := usr.GetAutoArchiveUserSettings(&fetchedSettings)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), fetchedSettings, 1)
+	assert.Equal(s.T(), user.ID, fetchedSettings[0].UserID)
+}
+
+func TestUserSettingRepository(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestUserSettingRePositorySuite))
+}
@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AttachmentRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type attachmentRouter struct {
+	attachmentController controllers.AttachmentController
+}
+
+func NewAttachmentRouter(attachmentController controllers.AttachmentController) AttachmentRouter {
+	return &attachmentRouter{attachmentController}
+}
+
+func (ar *attachmentRouter) SetRouting(r *gin.Engine) {
+	r.POST("/todos/:id/attachments", ar.attachmentController.Upload)
+	r.GET("/todos/:id/attachments", ar.attachmentController.Index)
+	r.GET("/todos/:id/attachments/:attachment_id", ar.attachmentController.Download)
+	r.DELETE("/todos/:id/attachments/:attachment_id", ar.attachmentController.Delete)
+}
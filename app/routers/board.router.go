@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BoardRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type boardRouter struct {
+	boardController controllers.BoardController
+}
+
+func NewBoardRouter(boardController controllers.BoardController) BoardRouter {
+	return &boardRouter{boardController}
+}
+
+func (br *boardRouter) SetRouting(r *gin.Engine) {
+	r.POST("/boards", br.boardController.Create)
+	r.GET("/boards", br.boardController.Index)
+	r.GET("/boards/:id", br.boardController.Show)
+	r.PUT("/boards/:id", br.boardController.Update)
+	r.DELETE("/boards/:id", br.boardController.Delete)
+	r.POST("/boards/:id/move", br.boardController.Move)
+}
@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChecklistItemRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type checklistItemRouter struct {
+	checklistItemController controllers.ChecklistItemController
+}
+
+func NewChecklistItemRouter(checklistItemController controllers.ChecklistItemController) ChecklistItemRouter {
+	return &checklistItemRouter{checklistItemController}
+}
+
+func (cr *checklistItemRouter) SetRouting(r *gin.Engine) {
+	r.POST("/todos/:id/checklist_items", cr.checklistItemController.Create)
+	r.PUT("/todos/:id/checklist_items/:item_id", cr.checklistItemController.Update)
+	r.POST("/todos/:id/checklist_items/reorder", cr.checklistItemController.Reorder)
+	r.DELETE("/todos/:id/checklist_items/:item_id", cr.checklistItemController.Delete)
+}
@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CommentRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type commentRouter struct {
+	commentController controllers.CommentController
+}
+
+func NewCommentRouter(commentController controllers.CommentController) CommentRouter {
+	return &commentRouter{commentController}
+}
+
+func (cr *commentRouter) SetRouting(r *gin.Engine) {
+	r.POST("/todos/:id/comments", cr.commentController.Create)
+	r.GET("/todos/:id/comments", cr.commentController.Index)
+	r.PUT("/todos/:id/comments/:comment_id", cr.commentController.Update)
+	r.DELETE("/todos/:id/comments/:comment_id", cr.commentController.Delete)
+}
@@ -0,0 +1,25 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type notificationRouter struct {
+	notificationController controllers.NotificationController
+}
+
+func NewNotificationRouter(notificationController controllers.NotificationController) NotificationRouter {
+	return &notificationRouter{notificationController}
+}
+
+func (nr *notificationRouter) SetRouting(r *gin.Engine) {
+	r.GET("/notifications", nr.notificationController.Index)
+	r.POST("/notifications/read", nr.notificationController.ReadAll)
+	r.POST("/notifications/:id/read", nr.notificationController.Read)
+}
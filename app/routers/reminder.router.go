@@ -0,0 +1,25 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReminderRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type reminderRouter struct {
+	reminderController controllers.ReminderController
+}
+
+func NewReminderRouter(reminderController controllers.ReminderController) ReminderRouter {
+	return &reminderRouter{reminderController}
+}
+
+func (rr *reminderRouter) SetRouting(r *gin.Engine) {
+	r.POST("/todos/:id/reminders", rr.reminderController.Create)
+	r.GET("/todos/:id/reminders", rr.reminderController.Index)
+	r.DELETE("/todos/:id/reminders/:reminder_id", rr.reminderController.Delete)
+}
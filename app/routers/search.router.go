@@ -0,0 +1,23 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type searchRouter struct {
+	searchController controllers.SearchController
+}
+
+func NewSearchRouter(searchController controllers.SearchController) SearchRouter {
+	return &searchRouter{searchController}
+}
+
+func (sr *searchRouter) SetRouting(r *gin.Engine) {
+	r.GET("/search", sr.searchController.Index)
+}
@@ -0,0 +1,24 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type tagRouter struct {
+	tagController controllers.TagController
+}
+
+func NewTagRouter(tagController controllers.TagController) TagRouter {
+	return &tagRouter{tagController}
+}
+
+func (tr *tagRouter) SetRouting(r *gin.Engine) {
+	r.GET("/tags", tr.tagController.Index)
+	r.PUT("/todos/:id/tags", tr.tagController.Update)
+}
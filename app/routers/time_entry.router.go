@@ -0,0 +1,27 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TimeEntryRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type timeEntryRouter struct {
+	timeEntryController controllers.TimeEntryController
+}
+
+func NewTimeEntryRouter(timeEntryController controllers.TimeEntryController) TimeEntryRouter {
+	return &timeEntryRouter{timeEntryController}
+}
+
+func (ter *timeEntryRouter) SetRouting(r *gin.Engine) {
+	r.POST("/todos/:id/time_entries/start", ter.timeEntryController.Start)
+	r.POST("/todos/:id/time_entries/stop", ter.timeEntryController.Stop)
+	r.POST("/todos/:id/time_entries", ter.timeEntryController.Create)
+	r.GET("/todos/:id/time_entries", ter.timeEntryController.Index)
+	r.GET("/time_entries/report", ter.timeEntryController.Report)
+}
@@ -21,7 +21,27 @@ func NewTodoRouter(todoController controllers.TodoController) TodoRouter {
 func (tr *todoRouter) SetRouting(r *gin.Engine) {
 	r.POST("/todos/", tr.todoController.Create)
 	r.GET("/todos/", tr.todoController.Index)
+	r.POST("/todos/quick", tr.todoController.QuickAdd)
+	r.GET("/todos/assigned", tr.todoController.Assigned)
+	r.GET("/todos/shared", tr.todoController.Shared)
+	r.GET("/todos/trash", tr.todoController.Trash)
+	r.DELETE("/todos/trash", tr.todoController.EmptyTrash)
+	r.DELETE("/todos/trash/:id", tr.todoController.Purge)
 	r.GET("/todos/:id", tr.todoController.Show)
 	r.PUT("/todos/:id", tr.todoController.Update)
+	r.PATCH("/todos/:id", tr.todoController.Patch)
 	r.DELETE("/todos/:id", tr.todoController.Delete)
+	r.POST("/todos/:id/complete", tr.todoController.Complete)
+	r.POST("/todos/:id/reopen", tr.todoController.Reopen)
+	r.POST("/todos/:id/archive", tr.todoController.Archive)
+	r.POST("/todos/:id/unarchive", tr.todoController.Unarchive)
+	r.POST("/todos/:id/move", tr.todoController.Move)
+	r.PUT("/todos/:id/assignee", tr.todoController.Assign)
+	r.POST("/todos/:id/shares", tr.todoController.Share)
+	r.GET("/todos/:id/shares", tr.todoController.Shares)
+	r.DELETE("/todos/:id/shares/:user_id", tr.todoController.Unshare)
+	r.GET("/todos/:id/occurrences", tr.todoController.Occurrences)
+	r.POST("/todos/:id/restore", tr.todoController.Restore)
+	r.GET("/todos/:id/history", tr.todoController.History)
+	r.POST("/todos/:id/revert/:revision", tr.todoController.Revert)
 }
@@ -0,0 +1,23 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TodoBulkRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type todoBulkRouter struct {
+	todoBulkController controllers.TodoBulkController
+}
+
+func NewTodoBulkRouter(todoBulkController controllers.TodoBulkController) TodoBulkRouter {
+	return &todoBulkRouter{todoBulkController}
+}
+
+func (tbr *todoBulkRouter) SetRouting(r *gin.Engine) {
+	r.POST("/todos/bulk", tbr.todoBulkController.Bulk)
+}
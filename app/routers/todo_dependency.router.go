@@ -0,0 +1,25 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TodoDependencyRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type todoDependencyRouter struct {
+	todoDependencyController controllers.TodoDependencyController
+}
+
+func NewTodoDependencyRouter(todoDependencyController controllers.TodoDependencyController) TodoDependencyRouter {
+	return &todoDependencyRouter{todoDependencyController}
+}
+
+func (tdr *todoDependencyRouter) SetRouting(r *gin.Engine) {
+	r.POST("/todos/:id/dependencies", tdr.todoDependencyController.Create)
+	r.GET("/todos/:id/dependencies", tdr.todoDependencyController.Index)
+	r.DELETE("/todos/:id/dependencies/:blocker_id", tdr.todoDependencyController.Delete)
+}
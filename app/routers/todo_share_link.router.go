@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TodoShareLinkRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type todoShareLinkRouter struct {
+	todoShareLinkController controllers.TodoShareLinkController
+}
+
+func NewTodoShareLinkRouter(todoShareLinkController controllers.TodoShareLinkController) TodoShareLinkRouter {
+	return &todoShareLinkRouter{todoShareLinkController}
+}
+
+func (tslr *todoShareLinkRouter) SetRouting(r *gin.Engine) {
+	r.POST("/todos/:id/links", tslr.todoShareLinkController.Create)
+	r.GET("/todos/:id/links", tslr.todoShareLinkController.Index)
+	r.DELETE("/todos/:id/links/:link_id", tslr.todoShareLinkController.Delete)
+	r.GET("/shared/:token", tslr.todoShareLinkController.Show)
+}
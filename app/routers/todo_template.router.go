@@ -0,0 +1,29 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TodoTemplateRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type todoTemplateRouter struct {
+	todoTemplateController controllers.TodoTemplateController
+}
+
+func NewTodoTemplateRouter(todoTemplateController controllers.TodoTemplateController) TodoTemplateRouter {
+	return &todoTemplateRouter{todoTemplateController}
+}
+
+func (ttr *todoTemplateRouter) SetRouting(r *gin.Engine) {
+	r.POST("/templates", ttr.todoTemplateController.Create)
+	r.GET("/templates", ttr.todoTemplateController.Index)
+	r.GET("/templates/:id", ttr.todoTemplateController.Show)
+	r.PUT("/templates/:id", ttr.todoTemplateController.Update)
+	r.DELETE("/templates/:id", ttr.todoTemplateController.Delete)
+	r.POST("/templates/:id/instantiate", ttr.todoTemplateController.Instantiate)
+	r.POST("/todos/:id/template", ttr.todoTemplateController.CreateFromTodo)
+}
@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TodoViewRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type todoViewRouter struct {
+	todoViewController controllers.TodoViewController
+}
+
+func NewTodoViewRouter(todoViewController controllers.TodoViewController) TodoViewRouter {
+	return &todoViewRouter{todoViewController}
+}
+
+func (tvr *todoViewRouter) SetRouting(r *gin.Engine) {
+	r.POST("/views", tvr.todoViewController.Create)
+	r.GET("/views", tvr.todoViewController.Index)
+	r.GET("/views/:id", tvr.todoViewController.Show)
+	r.PUT("/views/:id", tvr.todoViewController.Update)
+	r.DELETE("/views/:id", tvr.todoViewController.Delete)
+	r.GET("/views/:id/todos", tvr.todoViewController.Todos)
+}
@@ -0,0 +1,24 @@
+package routers
+
+import (
+	"app/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserSettingRouter interface {
+	SetRouting(r *gin.Engine)
+}
+
+type userSettingRouter struct {
+	userSettingController controllers.UserSettingController
+}
+
+func NewUserSettingRouter(userSettingController controllers.UserSettingController) UserSettingRouter {
+	return &userSettingRouter{userSettingController}
+}
+
+func (usr *userSettingRouter) SetRouting(r *gin.Engine) {
+	r.GET("/settings", usr.userSettingController.Show)
+	r.PUT("/settings", usr.userSettingController.Update)
+}
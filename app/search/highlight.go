@@ -0,0 +1,77 @@
+package search
+
+import (
+	"html"
+	"strings"
+)
+
+const (
+	highlightOpenTag  = "<mark>"
+	highlightCloseTag = "</mark>"
+
+	// NOTE: 抜粋の最大文字数と、最初の一致箇所より前に含める文字数
+	snippetLength       = 120
+	snippetLeadingChars = 30
+	snippetEllipsis     = "…"
+)
+
+// NOTE: HTMLをエスケープした上で、検索語に一致した箇所を<mark>で囲む(大文字・小文字は区別しない)
+func Highlight(text string, terms []string) string {
+	runes := []rune(text)
+	matched := matchedRunes(runes, terms)
+
+	var builder strings.Builder
+	for i, r := range runes {
+		if matched[i] && (i == 0 || !matched[i-1]) {
+			builder.WriteString(highlightOpenTag)
+		}
+		builder.WriteString(html.EscapeString(string(r)))
+		if matched[i] && (i == len(runes)-1 || !matched[i+1]) {
+			builder.WriteString(highlightCloseTag)
+		}
+	}
+	return builder.String()
+}
+
+// NOTE: 最初の一致箇所の周辺を抜き出してハイライトする(一致しない場合は先頭から抜き出す)
+func Snippet(text string, terms []string) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	start := 0
+	for i, matched := range matchedRunes(runes, terms) {
+		if matched {
+			start = max(i-snippetLeadingChars, 0)
+			break
+		}
+	}
+	end := min(start+snippetLength, len(runes))
+
+	snippet := Highlight(string(runes[start:end]), terms)
+	if start > 0 {
+		snippet = snippetEllipsis + snippet
+	}
+	if end < len(runes) {
+		snippet += snippetEllipsis
+	}
+	return snippet
+}
+
+// NOTE: 文字ごとに検索語のいずれかに一致しているかを返す
+func matchedRunes(runes []rune, terms []string) []bool {
+	// NOTE: strings.ToLowerは1文字ずつ変換するため、元の文字列と位置がずれない
+	lowerRunes := []rune(strings.ToLower(string(runes)))
+	matched := make([]bool, len(runes))
+	for _, term := range terms {
+		termRunes := []rune(strings.ToLower(term))
+		if len(termRunes) == 0 {
+			continue
+		}
+		for i := 0; i+len(termRunes) <= len(lowerRunes); i++ {
+			if string(lowerRunes[i:i+len(termRunes)]) == string(termRunes) {
+				for j := i; j < i+len(termRunes); j++ {
+					matched[j] = true
+				}
+			}
+		}
+	}
+	return matched
+}
@@ -0,0 +1,105 @@
+package search
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NOTE: 検索対象の文書(ReaderIDsは参照できるユーザ、コメントのTitleには親のTodoのタイトルを指定する)
+type Document struct {
+	Type      string
+	TodoID    int
+	CommentID *int
+	Title     string
+	Body      string
+	ReaderIDs []int
+}
+
+// NOTE: テスト用のメモリ上の実装(検索語の出現回数を点数とし、Todoのタイトルは2倍に重み付けする)
+type MemorySearcher struct {
+	mutex     sync.RWMutex
+	documents []Document
+}
+
+func NewMemorySearcher() *MemorySearcher {
+	return &MemorySearcher{}
+}
+
+// NOTE: 同じTodo・コメントの文書は置き換える
+func (ms *MemorySearcher) Index(documents ...Document) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	for _, document := range documents {
+		index := slices.IndexFunc(ms.documents, func(d Document) bool { return sameDocument(d, document) })
+		if index >= 0 {
+			ms.documents[index] = document
+			continue
+		}
+		ms.documents = append(ms.documents, document)
+	}
+}
+
+func (ms *MemorySearcher) Search(query Query) ([]Hit, error) {
+	ms.mutex.RLock()
+	defer ms.mutex.RUnlock()
+
+	terms := Terms(query.Keyword)
+	hits := []Hit{}
+	for _, document := range ms.documents {
+		if !slices.Contains(document.ReaderIDs, query.UserID) {
+			continue
+		}
+		score := countTerms(document.Body, terms)
+		if document.Type == HitTypeTodo {
+			score += countTerms(document.Title, terms) * 2
+		}
+		if score == 0 {
+			continue
+		}
+		hits = append(hits, Hit{Type: document.Type, TodoID: document.TodoID, CommentID: document.CommentID, Title: document.Title, Body: document.Body, Score: float64(score)})
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		if hits[i].TodoID != hits[j].TodoID {
+			return hits[i].TodoID < hits[j].TodoID
+		}
+		// NOTE: 同じTodoの中ではTodo自体を先に、コメントは投稿順に並べる
+		if hits[i].CommentID == nil || hits[j].CommentID == nil {
+			return hits[i].CommentID == nil && hits[j].CommentID != nil
+		}
+		return *hits[i].CommentID < *hits[j].CommentID
+	})
+	if query.Offset >= len(hits) {
+		return []Hit{}, nil
+	}
+	hits = hits[query.Offset:]
+	if query.Limit > 0 && query.Limit < len(hits) {
+		hits = hits[:query.Limit]
+	}
+	return hits, nil
+}
+
+func sameDocument(a Document, b Document) bool {
+	if a.Type != b.Type || a.TodoID != b.TodoID {
+		return false
+	}
+	if a.CommentID == nil || b.CommentID == nil {
+		return a.CommentID == nil && b.CommentID == nil
+	}
+	return *a.CommentID == *b.CommentID
+}
+
+func countTerms(text string, terms []string) int {
+	lowerText := strings.ToLower(text)
+	count := 0
+	for _, term := range terms {
+		count += strings.Count(lowerText, term)
+	}
+	return count
+}
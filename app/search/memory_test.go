@@ -0,0 +1,59 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestMemorySearcherSuite struct {
+	suite.Suite
+	searcher *MemorySearcher
+}
+
+func (s *TestMemorySearcherSuite) SetupTest() {
+	commentId := 10
+	s.searcher = NewMemorySearcher()
+	s.searcher.Index(
+		Document{Type: HitTypeTodo, TodoID: 1, Title: "会議の準備", Body: "資料を印刷する", ReaderIDs: []int{1}},
+		Document{Type: HitTypeTodo, TodoID: 2, Title: "買い物", Body: "会議室の予約も忘れずに", ReaderIDs: []int{1, 2}},
+		Document{Type: HitTypeComment, TodoID: 2, CommentID: &commentId, Title: "買い物", Body: "会議は15時からです", ReaderIDs: []int{1, 2}},
+		Document{Type: HitTypeTodo, TodoID: 3, Title: "会議の議事録", Body: "", ReaderIDs: []int{2}},
+	)
+}
+
+func (s *TestMemorySearcherSuite) TestSearch() {
+	hits, err := s.searcher.Search(Query{Keyword: "会議", UserID: 1})
+
+	// NOTE: タイトルに一致したTodoを上位とし、参照できないTodoは含まないこと
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), hits, 3)
+	assert.Equal(s.T(), 1, hits[0].TodoID)
+	assert.Equal(s.T(), HitTypeTodo, hits[1].Type)
+	assert.Equal(s.T(), HitTypeComment, hits[2].Type)
+	assert.Equal(s.T(), 10, *hits[2].CommentID)
+}
+
+func (s *TestMemorySearcherSuite) TestSearch_Pagination() {
+	hits, _ := s.searcher.Search(Query{Keyword: "会議", UserID: 1, Limit: 1, Offset: 1})
+
+	assert.Len(s.T(), hits, 1)
+	assert.Equal(s.T(), 2, hits[0].TodoID)
+	outOfRange, _ := s.searcher.Search(Query{Keyword: "会議", UserID: 1, Limit: 1, Offset: 5})
+	assert.Len(s.T(), outOfRange, 0)
+}
+
+func (s *TestMemorySearcherSuite) TestIndex_Replace() {
+	s.searcher.Index(Document{Type: HitTypeTodo, TodoID: 1, Title: "掃除", Body: "", ReaderIDs: []int{1}})
+
+	hits, _ := s.searcher.Search(Query{Keyword: "掃除", UserID: 1})
+
+	assert.Len(s.T(), hits, 1)
+	meetings, _ := s.searcher.Search(Query{Keyword: "準備", UserID: 1})
+	assert.Len(s.T(), meetings, 0)
+}
+
+func TestMemorySearcher(t *testing.T) {
+	suite.Run(t, new(TestMemorySearcherSuite))
+}
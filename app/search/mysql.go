@@ -0,0 +1,45 @@
+package search
+
+import "gorm.io/gorm"
+
+// NOTE: ゴミ箱のTodoは対象外とし、作成者・担当者・共有相手のみ参照できる
+const mysqlVisibleTodoCondition = `todos.deleted_at IS NULL AND (todos.user_id = @user_id OR todos.assignee_id = @user_id OR todos.id IN (SELECT todo_id FROM todo_shares WHERE user_id = @user_id))`
+
+// NOTE: ngramパーサのFULLTEXTインデックス(idx_todos_fulltext・idx_comments_fulltext)を自然言語モードで検索する
+const mysqlSearchQuery = `SELECT * FROM (
+	SELECT 'todo' AS type, todos.id AS todo_id, NULL AS comment_id, todos.title AS title, todos.content AS body,
+		MATCH(todos.title, todos.content) AGAINST (@keyword IN NATURAL LANGUAGE MODE) AS score
+	FROM todos
+	WHERE ` + mysqlVisibleTodoCondition + ` AND MATCH(todos.title, todos.content) AGAINST (@keyword IN NATURAL LANGUAGE MODE)
+	UNION ALL
+	SELECT 'comment' AS type, todos.id AS todo_id, comments.id AS comment_id, todos.title AS title, comments.body AS body,
+		MATCH(comments.body) AGAINST (@keyword IN NATURAL LANGUAGE MODE) AS score
+	FROM comments
+	INNER JOIN todos ON todos.id = comments.todo_id
+	WHERE ` + mysqlVisibleTodoCondition + ` AND MATCH(comments.body) AGAINST (@keyword IN NATURAL LANGUAGE MODE)
+) AS hits
+ORDER BY score DESC, todo_id ASC, comment_id ASC
+LIMIT @limit OFFSET @offset`
+
+type mysqlSearcher struct {
+	db *gorm.DB
+}
+
+func NewMySQLSearcher(db *gorm.DB) Searcher {
+	return &mysqlSearcher{db}
+}
+
+func (ms *mysqlSearcher) Search(query Query) ([]Hit, error) {
+	hits := []Hit{}
+	err := ms.db.Raw(mysqlSearchQuery, map[string]interface{}{
+		"keyword": query.Keyword,
+		"user_id": query.UserID,
+		"limit":   query.Limit,
+		"offset":  query.Offset,
+	}).Scan(&hits).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return hits, nil
+}
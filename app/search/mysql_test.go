@@ -0,0 +1,38 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type TestMySQLSearcherSuite struct {
+	suite.Suite
+}
+
+// NOTE: DBに接続せず、組み立てたSQLのみを検証する
+func (s *TestMySQLSearcherSuite) TestSearch_DryRun() {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:password@tcp(localhost:3306)/test", SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		s.T().Fatalf("failed to initialize GORM DB: %v", err)
+	}
+
+	var statement *gorm.Statement
+	db.Callback().Row().After("gorm:row").Register("test:capture", func(tx *gorm.DB) {
+		statement = tx.Statement
+	})
+	_, err = NewMySQLSearcher(db).Search(Query{Keyword: "会議", UserID: 1, Limit: 20, Offset: 40})
+
+	// NOTE: DryRunではSQLの組み立てのみ行われ、結果の読み込みはエラーになる
+	assert.ErrorIs(s.T(), err, gorm.ErrDryRunModeUnsupported)
+	assert.Contains(s.T(), statement.SQL.String(), "MATCH(todos.title, todos.content) AGAINST (? IN NATURAL LANGUAGE MODE)")
+	assert.Contains(s.T(), statement.SQL.String(), "MATCH(comments.body) AGAINST (? IN NATURAL LANGUAGE MODE)")
+	assert.Equal(s.T(), []interface{}{"会議", 1, 20, 40}, []interface{}{statement.Vars[0], statement.Vars[2], statement.Vars[len(statement.Vars)-2], statement.Vars[len(statement.Vars)-1]})
+}
+
+func TestMySQLSearcher(t *testing.T) {
+	suite.Run(t, new(TestMySQLSearcherSuite))
+}
@@ -0,0 +1,46 @@
+package search
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	HitTypeTodo    = "todo"
+	HitTypeComment = "comment"
+)
+
+// NOTE: Todo・コメントの全文検索(本番はMySQLのFULLTEXTインデックス、テストではメモリ上の実装を利用する)
+type Searcher interface {
+	Search(query Query) ([]Hit, error)
+}
+
+// NOTE: UserIDのユーザが参照できるTodo(作成者・担当者・共有相手)とそのコメントのみを検索する
+type Query struct {
+	Keyword string
+	UserID  int
+	Limit   int
+	Offset  int
+}
+
+// NOTE: 検索に一致したTodo・コメント(TitleはTodoのタイトル、BodyはTodoの内容またはコメントの本文)
+type Hit struct {
+	Type      string
+	TodoID    int
+	CommentID *int
+	Title     string
+	Body      string
+	Score     float64
+}
+
+// NOTE: 空白(全角スペースを含む)で区切った検索語を小文字にして重複を除いて返す
+func Terms(keyword string) []string {
+	terms := []string{}
+	for _, field := range strings.Fields(keyword) {
+		term := strings.ToLower(field)
+		if !slices.Contains(terms, term) {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
@@ -0,0 +1,41 @@
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestSearchSuite struct {
+	suite.Suite
+}
+
+func (s *TestSearchSuite) TestTerms() {
+	// NOTE: 全角スペースでも区切り、大文字・小文字の違いは重複として除くこと
+	assert.Equal(s.T(), []string{"report", "会議", "資料"}, Terms(" Report  会議　資料 REPORT "))
+	assert.Equal(s.T(), []string{}, Terms("   "))
+}
+
+func (s *TestSearchSuite) TestHighlight() {
+	assert.Equal(s.T(), "Write <mark>Report</mark> &lt;b&gt; for <mark>会議</mark>", Highlight("Write Report <b> for 会議", []string{"report", "会議"}))
+	// NOTE: 隣接・重複する一致箇所は1つのタグにまとめること
+	assert.Equal(s.T(), "<mark>abcd</mark>e", Highlight("abcde", []string{"abc", "bcd"}))
+}
+
+func (s *TestSearchSuite) TestSnippet() {
+	text := strings.Repeat("あ", 100) + "会議の資料" + strings.Repeat("い", 200)
+
+	snippet := Snippet(text, []string{"会議"})
+
+	// NOTE: 一致箇所の30文字前から120文字を抜き出すこと
+	assert.Equal(s.T(), "…"+strings.Repeat("あ", 30)+"<mark>会議</mark>の資料"+strings.Repeat("い", 85)+"…", snippet)
+	// NOTE: 一致しない場合は先頭から抜き出し、改行は空白にまとめること
+	assert.Equal(s.T(), "short text", Snippet("short\n\ntext", []string{"none"}))
+}
+
+func TestSearch(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestSearchSuite))
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/storage"
+	"app/utils"
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// NOTE: 内容から判定したMIMEタイプのうち添付を許可するもの
+var allowedAttachmentContentTypes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"application/zip",
+	"text/plain",
+	"text/csv",
+}
+
+type AttachmentService interface {
+	MaxUploadSize() int64
+	UploadAttachment(todoId int, requestParams dto.UploadAttachmentRequest, userId int) *dto.UploadAttachmentResponse
+	FetchAttachmentsList(todoId int, userId int) *dto.AttachmentsListResponse
+	DownloadAttachment(todoId int, id int, userId int) *dto.DownloadAttachmentResponse
+	DeleteAttachment(todoId int, id int, userId int) *dto.DeleteAttachmentResponse
+	PurgeOrphanedAttachments() *dto.PurgeAttachmentsResponse
+}
+
+type attachmentService struct {
+	attachmentRepository repositories.AttachmentRepository
+	storage              storage.Storage
+	maxSize              int64
+	authorizer           todoAuthorizer
+}
+
+func NewAttachmentService(todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, attachmentRepository repositories.AttachmentRepository, storage storage.Storage, maxSize int64) AttachmentService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	return &attachmentService{attachmentRepository, storage, maxSize, authorizer}
+}
+
+func (as *attachmentService) MaxUploadSize() int64 {
+	return as.maxSize
+}
+
+func (as *attachmentService) UploadAttachment(todoId int, requestParams dto.UploadAttachmentRequest, userId int) *dto.UploadAttachmentResponse {
+	todo := models.Todo{}
+	if errorType, err := as.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionEdit); err != nil {
+		return &dto.UploadAttachmentResponse{Attachment: models.Attachment{}, Error: err, ErrorType: errorType}
+	}
+	if requestParams.Size > as.maxSize {
+		return &dto.UploadAttachmentResponse{Attachment: models.Attachment{}, Error: fmt.Errorf("file size must be at most %d bytes", as.maxSize), ErrorType: "requestEntityTooLarge"}
+	}
+
+	// NOTE: 申告されたContent-Typeではなく先頭のバイト列から種類を判定する
+	head := make([]byte, 512)
+	n, err := io.ReadFull(requestParams.File, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return &dto.UploadAttachmentResponse{Attachment: models.Attachment{}, Error: err, ErrorType: "badRequest"}
+	}
+	head = head[:n]
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if contentType == "text/plain" && filepath.Ext(requestParams.FileName) == ".csv" {
+		contentType = "text/csv"
+	}
+	if !slices.Contains(allowedAttachmentContentTypes, contentType) {
+		return &dto.UploadAttachmentResponse{Attachment: models.Attachment{}, Error: fmt.Errorf("content type %s is not allowed", contentType), ErrorType: "unsupportedMediaType"}
+	}
+
+	attachment := models.Attachment{}
+	attachment.TodoID = todo.ID
+	attachment.UserID = userId
+	attachment.FileName = filepath.Base(requestParams.FileName)
+	attachment.ContentType = contentType
+	attachment.Size = requestParams.Size
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(attachment)
+	if validationErrors != nil {
+		return &dto.UploadAttachmentResponse{Attachment: attachment, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	token, err := utils.GenerateToken(16)
+	if err != nil {
+		return &dto.UploadAttachmentResponse{Attachment: attachment, Error: err, ErrorType: "internalServerError"}
+	}
+	attachment.StorageKey = "todos/" + strconv.Itoa(todo.ID) + "/" + token
+	body := io.LimitReader(io.MultiReader(bytes.NewReader(head), requestParams.File), as.maxSize)
+	if err := as.storage.Put(attachment.StorageKey, body, attachment.Size, attachment.ContentType); err != nil {
+		return &dto.UploadAttachmentResponse{Attachment: attachment, Error: err, ErrorType: "internalServerError"}
+	}
+	if err := as.attachmentRepository.CreateAttachment(&attachment); err != nil {
+		// NOTE: 登録に失敗した場合は保存したファイルを残さない
+		as.storage.Delete(attachment.StorageKey)
+		return &dto.UploadAttachmentResponse{Attachment: attachment, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.UploadAttachmentResponse{Attachment: attachment, Error: nil, ErrorType: ""}
+}
+
+func (as *attachmentService) FetchAttachmentsList(todoId int, userId int) *dto.AttachmentsListResponse {
+	todo := models.Todo{}
+	if errorType, err := as.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.AttachmentsListResponse{Attachments: []models.Attachment{}, Error: err, ErrorType: errorType}
+	}
+
+	attachments := []models.Attachment{}
+	if err := as.attachmentRepository.GetAttachments(&attachments, todo.ID); err != nil {
+		return &dto.AttachmentsListResponse{Attachments: []models.Attachment{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.AttachmentsListResponse{Attachments: attachments, Error: nil, ErrorType: ""}
+}
+
+func (as *attachmentService) DownloadAttachment(todoId int, id int, userId int) *dto.DownloadAttachmentResponse {
+	todo := models.Todo{}
+	if errorType, err := as.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.DownloadAttachmentResponse{Attachment: models.Attachment{}, Error: err, ErrorType: errorType}
+	}
+	attachment := models.Attachment{}
+	if err := as.attachmentRepository.GetAttachmentById(&attachment, id, todo.ID); err != nil {
+		return &dto.DownloadAttachmentResponse{Attachment: models.Attachment{}, Error: err, ErrorType: "notFound"}
+	}
+
+	body, err := as.storage.Get(attachment.StorageKey)
+	if err != nil {
+		return &dto.DownloadAttachmentResponse{Attachment: attachment, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.DownloadAttachmentResponse{Attachment: attachment, Body: body, Error: nil, ErrorType: ""}
+}
+
+func (as *attachmentService) DeleteAttachment(todoId int, id int, userId int) *dto.DeleteAttachmentResponse {
+	todo := models.Todo{}
+	if errorType, err := as.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionEdit); err != nil {
+		return &dto.DeleteAttachmentResponse{Error: err, ErrorType: errorType}
+	}
+	attachment := models.Attachment{}
+	if err := as.attachmentRepository.GetAttachmentById(&attachment, id, todo.ID); err != nil {
+		return &dto.DeleteAttachmentResponse{Error: err, ErrorType: "notFound"}
+	}
+
+	if err := as.storage.Delete(attachment.StorageKey); err != nil {
+		return &dto.DeleteAttachmentResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	if err := as.attachmentRepository.DeleteAttachment(&attachment); err != nil {
+		return &dto.DeleteAttachmentResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.DeleteAttachmentResponse{Error: nil, ErrorType: ""}
+}
+
+// NOTE: 物理削除されたTodoの添付ファイルを保存先からも削除する
+func (as *attachmentService) PurgeOrphanedAttachments() *dto.PurgeAttachmentsResponse {
+	attachments := []models.Attachment{}
+	if err := as.attachmentRepository.GetOrphanedAttachments(&attachments); err != nil {
+		return &dto.PurgeAttachmentsResponse{PurgedCount: 0, Error: err, ErrorType: "internalServerError"}
+	}
+
+	var purgedCount, failedCount int64
+	for _, attachment := range attachments {
+		// NOTE: 削除に失敗したファイルは次回に再試行する
+		if err := as.storage.Delete(attachment.StorageKey); err != nil {
+			failedCount++
+			continue
+		}
+		if err := as.attachmentRepository.DeleteAttachment(&attachment); err != nil {
+			return &dto.PurgeAttachmentsResponse{PurgedCount: purgedCount, FailedCount: failedCount, Error: err, ErrorType: "internalServerError"}
+		}
+		purgedCount++
+	}
+	return &dto.PurgeAttachmentsResponse{PurgedCount: purgedCount, FailedCount: failedCount, Error: nil, ErrorType: ""}
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/storage"
+	"app/test/factories"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestAttachmentServiceSuite struct {
+	WithDbSuite
+}
+
+var testAttachmentService AttachmentService
+var testAttachmentStorage storage.Storage
+
+// NOTE: PNGのシグネチャ
+var testPngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func (s *TestAttachmentServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	attachmentRepository := repositories.NewAttachmentRepository(DbCon)
+	testAttachmentStorage = storage.NewLocalStorage(s.T().TempDir())
+	testAttachmentService = NewAttachmentService(todoRepository, todoShareRepository, attachmentRepository, testAttachmentStorage, 1024)
+}
+
+func (s *TestAttachmentServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestAttachmentServiceSuite) TestUploadAttachment() {
+	content := append(append([]byte{}, testPngHeader...), []byte("test image")...)
+	requestParams := dto.UploadAttachmentRequest{FileName: "test.png", Size: int64(len(content)), File: bytes.NewReader(content)}
+	result := testAttachmentService.UploadAttachment(todo.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "image/png", result.Attachment.ContentType)
+	assert.Equal(s.T(), "test.png", result.Attachment.FileName)
+
+	// NOTE: 保存した内容をそのままダウンロードできること
+	download := testAttachmentService.DownloadAttachment(todo.ID, result.Attachment.ID, user.ID)
+	assert.Nil(s.T(), download.Error)
+	defer download.Body.Close()
+	body, _ := io.ReadAll(download.Body)
+	assert.Equal(s.T(), content, body)
+}
+
+func (s *TestAttachmentServiceSuite) TestUploadAttachment_TooLarge() {
+	content := strings.Repeat("a", 1025)
+	requestParams := dto.UploadAttachmentRequest{FileName: "test.txt", Size: int64(len(content)), File: strings.NewReader(content)}
+	result := testAttachmentService.UploadAttachment(todo.ID, requestParams, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "requestEntityTooLarge", result.ErrorType)
+}
+
+func (s *TestAttachmentServiceSuite) TestUploadAttachment_UnsupportedMediaType() {
+	// NOTE: 拡張子や申告ではなく内容で判定されること
+	content := "<html><body>test</body></html>"
+	requestParams := dto.UploadAttachmentRequest{FileName: "test.png", Size: int64(len(content)), File: strings.NewReader(content)}
+	result := testAttachmentService.UploadAttachment(todo.ID, requestParams, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "unsupportedMediaType", result.ErrorType)
+}
+
+func (s *TestAttachmentServiceSuite) TestUploadAttachment_Permissions() {
+	viewer := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "viewer@example.com"}).(*models.User)
+	stranger := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "stranger@example.com"}).(*models.User)
+	for _, u := range []*models.User{viewer, stranger} {
+		if err := DbCon.Create(&u).Error; err != nil {
+			s.T().Fatalf("failed to create test user %v", err)
+		}
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: viewer.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	// NOTE: 閲覧者は添付を参照できるがアップロードはできないこと
+	content := "test text"
+	requestParams := dto.UploadAttachmentRequest{FileName: "test.txt", Size: int64(len(content)), File: strings.NewReader(content)}
+	assert.Nil(s.T(), testAttachmentService.FetchAttachmentsList(todo.ID, viewer.ID).Error)
+	assert.Equal(s.T(), "forbidden", testAttachmentService.UploadAttachment(todo.ID, requestParams, viewer.ID).ErrorType)
+	assert.Equal(s.T(), "notFound", testAttachmentService.FetchAttachmentsList(todo.ID, stranger.ID).ErrorType)
+}
+
+func (s *TestAttachmentServiceSuite) TestDeleteAttachment() {
+	content := "test text"
+	requestParams := dto.UploadAttachmentRequest{FileName: "test.txt", Size: int64(len(content)), File: strings.NewReader(content)}
+	uploaded := testAttachmentService.UploadAttachment(todo.ID, requestParams, user.ID)
+
+	result := testAttachmentService.DeleteAttachment(todo.ID, uploaded.Attachment.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	_, err := testAttachmentStorage.Get(uploaded.Attachment.StorageKey)
+	assert.ErrorIs(s.T(), err, storage.ErrObjectNotFound)
+	assert.Len(s.T(), testAttachmentService.FetchAttachmentsList(todo.ID, user.ID).Attachments, 0)
+}
+
+func (s *TestAttachmentServiceSuite) TestPurgeOrphanedAttachments() {
+	content := "test text"
+	requestParams := dto.UploadAttachmentRequest{FileName: "test.txt", Size: int64(len(content)), File: strings.NewReader(content)}
+	uploaded := testAttachmentService.UploadAttachment(todo.ID, requestParams, user.ID)
+
+	// NOTE: ゴミ箱にある間は削除されないこと
+	DbCon.Delete(&todo)
+	assert.Equal(s.T(), int64(0), testAttachmentService.PurgeOrphanedAttachments().PurgedCount)
+
+	DbCon.Unscoped().Delete(&todo)
+	result := testAttachmentService.PurgeOrphanedAttachments()
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), int64(1), result.PurgedCount)
+	assert.Equal(s.T(), int64(0), result.FailedCount)
+	_, err := testAttachmentStorage.Get(uploaded.Attachment.StorageKey)
+	assert.ErrorIs(s.T(), err, storage.ErrObjectNotFound)
+}
+
+// NOTE: 保存先からの削除に失敗するストレージ
+type failingDeleteStorage struct {
+	storage.Storage
+}
+
+func (fs failingDeleteStorage) Delete(key string) error {
+	return errors.New("storage unavailable")
+}
+
+func (s *TestAttachmentServiceSuite) TestPurgeOrphanedAttachments_StorageError() {
+	content := "test text"
+	requestParams := dto.UploadAttachmentRequest{FileName: "test.txt", Size: int64(len(content)), File: strings.NewReader(content)}
+	testAttachmentService.UploadAttachment(todo.ID, requestParams, user.ID)
+	DbCon.Unscoped().Delete(&todo)
+	attachmentService := NewAttachmentService(repositories.NewTodoRepository(DbCon), repositories.NewTodoShareRepository(DbCon), repositories.NewAttachmentRepository(DbCon), failingDeleteStorage{testAttachmentStorage}, 1024)
+
+	result := attachmentService.PurgeOrphanedAttachments()
+
+	// NOTE: 削除に失敗した添付ファイルは件数のみ返し、レコードを残して次回に再試行すること
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), int64(0), result.PurgedCount)
+	assert.Equal(s.T(), int64(1), result.FailedCount)
+	var count int64
+	DbCon.Model(&models.Attachment{}).Count(&count)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func TestAttachmentService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestAttachmentServiceSuite))
+}
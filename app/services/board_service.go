@@ -0,0 +1,210 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// NOTE: カードの移動の失敗をトランザクションのロールバックに伝えるためのエラー
+var errBoardCardMoveFailed = errors.New("board card move failed")
+
+type BoardService interface {
+	CreateBoard(requestParams dto.CreateBoardRequest, userId int) *dto.CreateBoardResponse
+	FetchBoardsList(userId int) *dto.BoardsListResponse
+	FetchBoard(id int, userId int) *dto.FetchBoardResponse
+	UpdateBoard(id int, requestParams dto.UpdateBoardRequest, userId int) *dto.UpdateBoardResponse
+	DeleteBoard(id int, userId int) *dto.DeleteBoardResponse
+	MoveBoardCard(id int, requestParams dto.MoveBoardCardRequest, userId int) *dto.MoveBoardCardResponse
+}
+
+type boardService struct {
+	boardRepository       repositories.BoardRepository
+	todoRepository        repositories.TodoRepository
+	transactionRepository repositories.TransactionRepository
+}
+
+func NewBoardService(boardRepository repositories.BoardRepository, todoRepository repositories.TodoRepository, transactionRepository repositories.TransactionRepository) BoardService {
+	return &boardService{boardRepository, todoRepository, transactionRepository}
+}
+
+func (bs *boardService) CreateBoard(requestParams dto.CreateBoardRequest, userId int) *dto.CreateBoardResponse {
+	board := models.Board{}
+	board.UserID = userId
+	if errorType, err := bs.setBoardFields(&board, requestParams.Name, requestParams.GroupBy, requestParams.Columns); err != nil {
+		return &dto.CreateBoardResponse{Board: board, Error: err, ErrorType: errorType}
+	}
+
+	if err := bs.boardRepository.CreateBoard(&board); err != nil {
+		return &dto.CreateBoardResponse{Board: board, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.CreateBoardResponse{Board: board, Error: nil, ErrorType: ""}
+}
+
+func (bs *boardService) FetchBoardsList(userId int) *dto.BoardsListResponse {
+	boards := []models.Board{}
+	if err := bs.boardRepository.GetBoards(&boards, userId); err != nil {
+		return &dto.BoardsListResponse{Boards: []models.Board{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.BoardsListResponse{Boards: boards, Error: nil, ErrorType: ""}
+}
+
+// NOTE: Todoは該当する最初の列に並び順のまま振り分け、どの列にも該当しないものは含めない
+func (bs *boardService) FetchBoard(id int, userId int) *dto.FetchBoardResponse {
+	board := models.Board{}
+	if err := bs.boardRepository.GetBoardById(&board, id, userId); err != nil {
+		return &dto.FetchBoardResponse{Board: models.Board{}, Columns: []dto.BoardColumnTodos{}, Error: err, ErrorType: "notFound"}
+	}
+	todos := []models.Todo{}
+	if err := bs.todoRepository.GetAllTodos(&todos, userId); err != nil {
+		return &dto.FetchBoardResponse{Board: board, Columns: []dto.BoardColumnTodos{}, Error: err, ErrorType: "internalServerError"}
+	}
+
+	columns := []dto.BoardColumnTodos{}
+	columnIndexes := map[int]int{}
+	for i, column := range board.Columns {
+		columns = append(columns, dto.BoardColumnTodos{Column: column, Todos: []models.Todo{}})
+		columnIndexes[column.ID] = i
+	}
+	for _, todo := range todos {
+		if column := board.ColumnFor(todo); column != nil {
+			index := columnIndexes[column.ID]
+			columns[index].Todos = append(columns[index].Todos, todo)
+		}
+	}
+	return &dto.FetchBoardResponse{Board: board, Columns: columns, Error: nil, ErrorType: ""}
+}
+
+func (bs *boardService) UpdateBoard(id int, requestParams dto.UpdateBoardRequest, userId int) *dto.UpdateBoardResponse {
+	board := models.Board{}
+	if err := bs.boardRepository.GetBoardById(&board, id, userId); err != nil {
+		return &dto.UpdateBoardResponse{Board: models.Board{}, Error: err, ErrorType: "notFound"}
+	}
+	if errorType, err := bs.setBoardFields(&board, requestParams.Name, requestParams.GroupBy, requestParams.Columns); err != nil {
+		return &dto.UpdateBoardResponse{Board: board, Error: err, ErrorType: errorType}
+	}
+
+	if err := bs.boardRepository.UpdateBoard(&board); err != nil {
+		return &dto.UpdateBoardResponse{Board: board, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.UpdateBoardResponse{Board: board, Error: nil, ErrorType: ""}
+}
+
+func (bs *boardService) DeleteBoard(id int, userId int) *dto.DeleteBoardResponse {
+	board := models.Board{}
+	if err := bs.boardRepository.GetBoardById(&board, id, userId); err != nil {
+		return &dto.DeleteBoardResponse{Error: err, ErrorType: "notFound"}
+	}
+
+	if err := bs.boardRepository.DeleteBoard(&board); err != nil {
+		return &dto.DeleteBoardResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.DeleteBoardResponse{Error: nil, ErrorType: ""}
+}
+
+// NOTE: 列に対応するTodoの項目の更新と並び順の変更を1つのトランザクションで行う
+func (bs *boardService) MoveBoardCard(id int, requestParams dto.MoveBoardCardRequest, userId int) *dto.MoveBoardCardResponse {
+	board := models.Board{}
+	if err := bs.boardRepository.GetBoardById(&board, id, userId); err != nil {
+		return &dto.MoveBoardCardResponse{Todo: models.Todo{}, Error: err, ErrorType: "notFound"}
+	}
+	index := slices.IndexFunc(board.Columns, func(column models.BoardColumn) bool { return column.ID == requestParams.ColumnID })
+	if index < 0 {
+		return &dto.MoveBoardCardResponse{Todo: models.Todo{}, Error: fmt.Errorf("column not found"), ErrorType: "badRequest"}
+	}
+	column := board.Columns[index]
+
+	todo := models.Todo{}
+	var moveError error
+	var errorType string
+	err := bs.transactionRepository.Transaction(func(tx repositories.TransactionRepository) error {
+		todoService := NewTodoService(tx.TodoRepository(), tx.TodoRevisionRepository(), tx.UserRepository(), tx.TodoShareRepository(), tx.NotificationRepository(), tx.UserSettingRepository(), tx)
+		if err := tx.TodoRepository().GetTodoById(&todo, requestParams.TodoID, userId); err != nil {
+			moveError, errorType = err, "notFound"
+			return errBoardCardMoveFailed
+		}
+
+		if errorType, moveError = bs.applyColumn(tx, todoService, board, column, todo, userId); moveError != nil {
+			return errBoardCardMoveFailed
+		}
+		if requestParams.BeforeID != nil || requestParams.AfterID != nil {
+			result := todoService.MoveTodo(todo.ID, dto.MoveTodoRequest{BeforeID: requestParams.BeforeID, AfterID: requestParams.AfterID}, userId)
+			if result.Error != nil {
+				moveError, errorType = result.Error, result.ErrorType
+				return errBoardCardMoveFailed
+			}
+		}
+		return tx.TodoRepository().GetTodoById(&todo, todo.ID, userId)
+	})
+
+	if moveError != nil {
+		return &dto.MoveBoardCardResponse{Todo: models.Todo{}, Error: moveError, ErrorType: errorType}
+	}
+	if err != nil {
+		return &dto.MoveBoardCardResponse{Todo: models.Todo{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.MoveBoardCardResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+// NOTE: ステータスの変更は完了・再開の処理を通し、タグはボードの他の列のタグを外して付け替える
+func (bs *boardService) applyColumn(tx repositories.TransactionRepository, todoService TodoService, board models.Board, column models.BoardColumn, todo models.Todo, userId int) (string, error) {
+	if board.GroupBy == models.BoardGroupByStatus {
+		if column.Value == todo.Status {
+			return "", nil
+		}
+		if column.Value == models.TodoStatusDone {
+			result := todoService.CompleteTodo(todo.ID, dto.CompleteTodoRequest{}, userId)
+			return result.ErrorType, result.Error
+		}
+		result := todoService.ReopenTodo(todo.ID, userId)
+		return result.ErrorType, result.Error
+	}
+
+	names := []string{column.Value}
+	for _, name := range todo.Tags {
+		if !slices.ContainsFunc(board.Columns, func(c models.BoardColumn) bool { return c.Value == name }) {
+			names = append(names, name)
+		}
+	}
+	names, err := normalizeTagNames(names)
+	if err != nil {
+		return "badRequest", err
+	}
+	if err := tx.TagRepository().ReplaceTodoTags(&todo, names); err != nil {
+		return "internalServerError", err
+	}
+	return "", nil
+}
+
+func (bs *boardService) setBoardFields(board *models.Board, name string, groupBy string, columnParams []dto.BoardColumnRequest) (string, error) {
+	board.Name = name
+	board.GroupBy = groupBy
+	board.Columns = []models.BoardColumn{}
+	values := []string{}
+	for i, columnParam := range columnParams {
+		value := columnParam.Value
+		if groupBy == models.BoardGroupByTag {
+			value = models.NormalizeTagName(value)
+		}
+		if groupBy == models.BoardGroupByStatus && value != models.TodoStatusTodo && value != models.TodoStatusDone {
+			return "badRequest", fmt.Errorf("status column value must be one of %s, %s", models.TodoStatusTodo, models.TodoStatusDone)
+		}
+		if slices.Contains(values, value) {
+			return "badRequest", fmt.Errorf("column values must be unique")
+		}
+		values = append(values, value)
+		board.Columns = append(board.Columns, models.BoardColumn{Name: columnParam.Name, Value: value, Position: i})
+	}
+
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	if validationErrors := validate.Struct(board); validationErrors != nil {
+		return "validationError", validationErrors
+	}
+	return "", nil
+}
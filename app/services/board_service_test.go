@@ -0,0 +1,136 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestBoardServiceSuite struct {
+	WithDbSuite
+}
+
+var testBoardService BoardService
+
+func (s *TestBoardServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID, Position: "a"}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	boardRepository := repositories.NewBoardRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	transactionRepository := repositories.NewTransactionRepository(DbCon)
+	testBoardService = NewBoardService(boardRepository, todoRepository, transactionRepository)
+}
+
+func (s *TestBoardServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestBoardServiceSuite) createStatusBoard() models.Board {
+	result := testBoardService.CreateBoard(dto.CreateBoardRequest{Name: "test board", GroupBy: models.BoardGroupByStatus, Columns: []dto.BoardColumnRequest{
+		{Name: "Todo", Value: models.TodoStatusTodo},
+		{Name: "Done", Value: models.TodoStatusDone},
+	}}, user.ID)
+	if result.Error != nil {
+		s.T().Fatalf("failed to create test board %v", result.Error)
+	}
+	return result.Board
+}
+
+func (s *TestBoardServiceSuite) TestCreateBoard_Invalid() {
+	invalidStatus := testBoardService.CreateBoard(dto.CreateBoardRequest{Name: "test board", GroupBy: models.BoardGroupByStatus, Columns: []dto.BoardColumnRequest{{Name: "Doing", Value: "doing"}}}, user.ID)
+	assert.Equal(s.T(), "badRequest", invalidStatus.ErrorType)
+
+	duplicatedTag := testBoardService.CreateBoard(dto.CreateBoardRequest{Name: "test board", GroupBy: models.BoardGroupByTag, Columns: []dto.BoardColumnRequest{{Name: "Home", Value: "home"}, {Name: "Home2", Value: "#Home"}}}, user.ID)
+	assert.Equal(s.T(), "badRequest", duplicatedTag.ErrorType)
+
+	noColumns := testBoardService.CreateBoard(dto.CreateBoardRequest{Name: "test board", GroupBy: models.BoardGroupByTag}, user.ID)
+	assert.Equal(s.T(), "validationError", noColumns.ErrorType)
+}
+
+func (s *TestBoardServiceSuite) TestFetchBoard() {
+	board := s.createStatusBoard()
+	doneTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID, Status: models.TodoStatusDone, Position: "b"}
+	if err := DbCon.Create(&doneTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	result := testBoardService.FetchBoard(board.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Columns, 2)
+	assert.Equal(s.T(), todo.ID, result.Columns[0].Todos[0].ID)
+	assert.Equal(s.T(), doneTodo.ID, result.Columns[1].Todos[0].ID)
+}
+
+func (s *TestBoardServiceSuite) TestMoveBoardCard_Status() {
+	board := s.createStatusBoard()
+	doneTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID, Status: models.TodoStatusDone, Position: "b"}
+	if err := DbCon.Create(&doneTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	// NOTE: 完了の列の先頭に移動すると完了になり並び順も変わること
+	result := testBoardService.MoveBoardCard(board.ID, dto.MoveBoardCardRequest{TodoID: todo.ID, ColumnID: board.Columns[1].ID, BeforeID: &doneTodo.ID}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), models.TodoStatusDone, result.Todo.Status)
+	columns := testBoardService.FetchBoard(board.ID, user.ID).Columns
+	assert.Len(s.T(), columns[0].Todos, 0)
+	assert.Equal(s.T(), []int{todo.ID, doneTodo.ID}, []int{columns[1].Todos[0].ID, columns[1].Todos[1].ID})
+}
+
+func (s *TestBoardServiceSuite) TestMoveBoardCard_Tag() {
+	result := testBoardService.CreateBoard(dto.CreateBoardRequest{Name: "test board", GroupBy: models.BoardGroupByTag, Columns: []dto.BoardColumnRequest{
+		{Name: "Backlog", Value: "backlog"},
+		{Name: "Doing", Value: "doing"},
+	}}, user.ID)
+	board := result.Board
+	todoTags := []models.TodoTag{{TodoID: todo.ID, Name: "backlog"}, {TodoID: todo.ID, Name: "home"}}
+	if err := DbCon.Create(&todoTags).Error; err != nil {
+		s.T().Fatalf("failed to create test todo tags %v", err)
+	}
+
+	moved := testBoardService.MoveBoardCard(board.ID, dto.MoveBoardCardRequest{TodoID: todo.ID, ColumnID: board.Columns[1].ID}, user.ID)
+
+	// NOTE: ボードの列以外のタグは残ること
+	assert.Nil(s.T(), moved.Error)
+	assert.Equal(s.T(), []string{"doing", "home"}, moved.Todo.Tags)
+}
+
+func (s *TestBoardServiceSuite) TestMoveBoardCard_RollbackOnFailure() {
+	board := s.createStatusBoard()
+	blockerTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID, Position: "b"}
+	if err := DbCon.Create(&blockerTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	if err := DbCon.Create(&models.TodoDependency{TodoID: todo.ID, BlockerID: blockerTodo.ID}).Error; err != nil {
+		s.T().Fatalf("failed to create test dependency %v", err)
+	}
+
+	result := testBoardService.MoveBoardCard(board.ID, dto.MoveBoardCardRequest{TodoID: todo.ID, ColumnID: board.Columns[1].ID}, user.ID)
+
+	assert.Equal(s.T(), "conflict", result.ErrorType)
+	fetchedTodo := models.Todo{}
+	DbCon.First(&fetchedTodo, todo.ID)
+	assert.Equal(s.T(), models.TodoStatusTodo, fetchedTodo.Status)
+}
+
+func TestBoardService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestBoardServiceSuite))
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"fmt"
+	"sort"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type ChecklistItemService interface {
+	CreateChecklistItem(todoId int, requestParams dto.CreateChecklistItemRequest, userId int) *dto.CreateChecklistItemResponse
+	UpdateChecklistItem(todoId int, id int, requestParams dto.UpdateChecklistItemRequest, userId int) *dto.UpdateChecklistItemResponse
+	ReorderChecklistItems(todoId int, requestParams dto.ReorderChecklistItemsRequest, userId int) *dto.ReorderChecklistItemsResponse
+	DeleteChecklistItem(todoId int, id int, userId int) *dto.DeleteChecklistItemResponse
+}
+
+type checklistItemService struct {
+	checklistItemRepository repositories.ChecklistItemRepository
+	authorizer              todoAuthorizer
+}
+
+func NewChecklistItemService(todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, checklistItemRepository repositories.ChecklistItemRepository) ChecklistItemService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	return &checklistItemService{checklistItemRepository, authorizer}
+}
+
+func (cs *checklistItemService) CreateChecklistItem(todoId int, requestParams dto.CreateChecklistItemRequest, userId int) *dto.CreateChecklistItemResponse {
+	// NOTE: Todoを編集できることを確認
+	todo := models.Todo{}
+	if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionEdit); err != nil {
+		return &dto.CreateChecklistItemResponse{ChecklistItem: models.ChecklistItem{}, Error: err, ErrorType: errorType}
+	}
+
+	item := models.ChecklistItem{}
+	item.TodoID = todo.ID
+	item.Title = requestParams.Title
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(item)
+	if validationErrors != nil {
+		return &dto.CreateChecklistItemResponse{ChecklistItem: item, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	// NOTE: Create処理
+	if err := cs.checklistItemRepository.CreateChecklistItem(&item); err != nil {
+		return &dto.CreateChecklistItemResponse{ChecklistItem: item, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.CreateChecklistItemResponse{ChecklistItem: item, Error: nil, ErrorType: ""}
+}
+
+func (cs *checklistItemService) UpdateChecklistItem(todoId int, id int, requestParams dto.UpdateChecklistItemRequest, userId int) *dto.UpdateChecklistItemResponse {
+	todo := models.Todo{}
+	if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionStatus); err != nil {
+		return &dto.UpdateChecklistItemResponse{ChecklistItem: models.ChecklistItem{}, Error: err, ErrorType: errorType}
+	}
+	item := models.ChecklistItem{}
+	if err := cs.checklistItemRepository.GetChecklistItemById(&item, id, todo.ID); err != nil {
+		return &dto.UpdateChecklistItemResponse{ChecklistItem: models.ChecklistItem{}, Error: err, ErrorType: "notFound"}
+	}
+	// NOTE: 完了状態の切り替えは担当者にも許可し、タイトルの変更には編集権限を必要とする
+	if requestParams.Title != item.Title {
+		if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionEdit); err != nil {
+			return &dto.UpdateChecklistItemResponse{ChecklistItem: item, Error: err, ErrorType: errorType}
+		}
+	}
+
+	item.Title = requestParams.Title
+	item.Done = requestParams.Done
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(item)
+	if validationErrors != nil {
+		return &dto.UpdateChecklistItemResponse{ChecklistItem: item, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	// NOTE: Update処理
+	if err := cs.checklistItemRepository.UpdateChecklistItem(&item); err != nil {
+		return &dto.UpdateChecklistItemResponse{ChecklistItem: item, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.UpdateChecklistItemResponse{ChecklistItem: item, Error: nil, ErrorType: ""}
+}
+
+func (cs *checklistItemService) ReorderChecklistItems(todoId int, requestParams dto.ReorderChecklistItemsRequest, userId int) *dto.ReorderChecklistItemsResponse {
+	todo := models.Todo{}
+	if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionEdit); err != nil {
+		return &dto.ReorderChecklistItemsResponse{ChecklistItems: []models.ChecklistItem{}, Error: err, ErrorType: errorType}
+	}
+
+	// NOTE: 指定されたIDがTodoのチェックリストと過不足なく一致することを確認
+	if !cs.isSameItemIds(todo.ChecklistItems, requestParams.ItemIDs) {
+		err := fmt.Errorf("item_idsにはTodoのチェックリストのIDを全て指定してください")
+		return &dto.ReorderChecklistItemsResponse{ChecklistItems: todo.ChecklistItems, Error: err, ErrorType: "badRequest"}
+	}
+
+	if err := cs.checklistItemRepository.ReorderChecklistItems(todo.ID, requestParams.ItemIDs); err != nil {
+		return &dto.ReorderChecklistItemsResponse{ChecklistItems: todo.ChecklistItems, Error: err, ErrorType: "internalServerError"}
+	}
+
+	items := []models.ChecklistItem{}
+	if err := cs.checklistItemRepository.GetChecklistItems(&items, todo.ID); err != nil {
+		return &dto.ReorderChecklistItemsResponse{ChecklistItems: []models.ChecklistItem{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.ReorderChecklistItemsResponse{ChecklistItems: items, Error: nil, ErrorType: ""}
+}
+
+func (cs *checklistItemService) DeleteChecklistItem(todoId int, id int, userId int) *dto.DeleteChecklistItemResponse {
+	todo := models.Todo{}
+	if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionEdit); err != nil {
+		return &dto.DeleteChecklistItemResponse{Error: err, ErrorType: errorType}
+	}
+	item := models.ChecklistItem{}
+	if err := cs.checklistItemRepository.GetChecklistItemById(&item, id, todo.ID); err != nil {
+		return &dto.DeleteChecklistItemResponse{Error: err, ErrorType: "notFound"}
+	}
+
+	if err := cs.checklistItemRepository.DeleteChecklistItem(&item); err != nil {
+		return &dto.DeleteChecklistItemResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.DeleteChecklistItemResponse{Error: nil, ErrorType: ""}
+}
+
+func (cs *checklistItemService) isSameItemIds(items []models.ChecklistItem, itemIds []int) bool {
+	if len(items) != len(itemIds) {
+		return false
+	}
+
+	currentIds := make([]int, len(items))
+	for i, item := range items {
+		currentIds[i] = item.ID
+	}
+	requestIds := append([]int{}, itemIds...)
+	sort.Ints(currentIds)
+	sort.Ints(requestIds)
+	for i := range currentIds {
+		if currentIds[i] != requestIds[i] {
+			return false
+		}
+	}
+	return true
+}
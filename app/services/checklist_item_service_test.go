@@ -0,0 +1,199 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestChecklistItemServiceSuite struct {
+	WithDbSuite
+}
+
+var (
+	todo                     models.Todo
+	testChecklistItemService ChecklistItemService
+)
+
+func (s *TestChecklistItemServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	checklistItemRepository := repositories.NewChecklistItemRepository(DbCon)
+	testChecklistItemService = NewChecklistItemService(todoRepository, todoShareRepository, checklistItemRepository)
+}
+
+func (s *TestChecklistItemServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestChecklistItemServiceSuite) TestCreateChecklistItem() {
+	requestParams := dto.CreateChecklistItemRequest{Title: "test item 1"}
+
+	result := testChecklistItemService.CreateChecklistItem(todo.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+
+	// NOTE: チェックリストが作成されていることを確認
+	item := models.ChecklistItem{}
+	if err := DbCon.Where("todo_id = ?", todo.ID).First(&item).Error; err != nil {
+		s.T().Fatalf("failed to create checklist item %v", err)
+	}
+	assert.Equal(s.T(), "test item 1", item.Title)
+}
+
+func (s *TestChecklistItemServiceSuite) TestCreateChecklistItem_ValidationError() {
+	requestParams := dto.CreateChecklistItemRequest{Title: ""}
+
+	result := testChecklistItemService.CreateChecklistItem(todo.ID, requestParams, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
+func (s *TestChecklistItemServiceSuite) TestCreateChecklistItem_OtherUsersTodo() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	requestParams := dto.CreateChecklistItemRequest{Title: "test item 1"}
+	result := testChecklistItemService.CreateChecklistItem(todo.ID, requestParams, otherUser.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "notFound", result.ErrorType)
+}
+
+func (s *TestChecklistItemServiceSuite) TestUpdateChecklistItem() {
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	requestParams := dto.UpdateChecklistItemRequest{Title: "test item 1", Done: true}
+	result := testChecklistItemService.UpdateChecklistItem(todo.ID, item.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+	assert.True(s.T(), result.ChecklistItem.Done)
+}
+
+func (s *TestChecklistItemServiceSuite) TestReorderChecklistItems() {
+	items := []models.ChecklistItem{
+		{TodoID: todo.ID, Title: "test item 1", Position: 1},
+		{TodoID: todo.ID, Title: "test item 2", Position: 2},
+	}
+	if err := DbCon.Create(&items).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist items %v", err)
+	}
+
+	requestParams := dto.ReorderChecklistItemsRequest{ItemIDs: []int{items[1].ID, items[0].ID}}
+	result := testChecklistItemService.ReorderChecklistItems(todo.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+	assert.Equal(s.T(), items[1].ID, result.ChecklistItems[0].ID)
+	assert.Equal(s.T(), items[0].ID, result.ChecklistItems[1].ID)
+}
+
+func (s *TestChecklistItemServiceSuite) TestReorderChecklistItems_BadRequest() {
+	items := []models.ChecklistItem{
+		{TodoID: todo.ID, Title: "test item 1", Position: 1},
+		{TodoID: todo.ID, Title: "test item 2", Position: 2},
+	}
+	if err := DbCon.Create(&items).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist items %v", err)
+	}
+
+	requestParams := dto.ReorderChecklistItemsRequest{ItemIDs: []int{items[1].ID}}
+	result := testChecklistItemService.ReorderChecklistItems(todo.ID, requestParams, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestChecklistItemServiceSuite) TestDeleteChecklistItem() {
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	result := testChecklistItemService.DeleteChecklistItem(todo.ID, item.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+}
+
+func (s *TestChecklistItemServiceSuite) TestChecklistItem_Editor() {
+	editor := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "editor@example.com"}).(*models.User)
+	if err := DbCon.Create(&editor).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: editor.ID, Role: models.TodoShareRoleEditor}).Error; err != nil {
+		s.T().Fatalf("failed to create test share %v", err)
+	}
+
+	// NOTE: 編集者はチェックリストの追加・更新・並び替え・削除ができること
+	created := testChecklistItemService.CreateChecklistItem(todo.ID, dto.CreateChecklistItemRequest{Title: "test item 1"}, editor.ID)
+	assert.Nil(s.T(), created.Error)
+	updated := testChecklistItemService.UpdateChecklistItem(todo.ID, created.ChecklistItem.ID, dto.UpdateChecklistItemRequest{Title: "test updated item 1", Done: true}, editor.ID)
+	assert.Nil(s.T(), updated.Error)
+	reordered := testChecklistItemService.ReorderChecklistItems(todo.ID, dto.ReorderChecklistItemsRequest{ItemIDs: []int{created.ChecklistItem.ID}}, editor.ID)
+	assert.Nil(s.T(), reordered.Error)
+	deleted := testChecklistItemService.DeleteChecklistItem(todo.ID, created.ChecklistItem.ID, editor.ID)
+	assert.Nil(s.T(), deleted.Error)
+}
+
+func (s *TestChecklistItemServiceSuite) TestChecklistItem_ViewerAndAssignee() {
+	viewer := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "viewer@example.com"}).(*models.User)
+	assignee := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "assignee@example.com"}).(*models.User)
+	for _, collaborator := range []*models.User{viewer, assignee} {
+		if err := DbCon.Create(&collaborator).Error; err != nil {
+			s.T().Fatalf("failed to create test user %v", err)
+		}
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: viewer.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test share %v", err)
+	}
+	if err := DbCon.Model(&todo).Update("assignee_id", assignee.ID).Error; err != nil {
+		s.T().Fatalf("failed to assign test todo %v", err)
+	}
+	item := models.ChecklistItem{TodoID: todo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&item).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	// NOTE: 閲覧者はチェックリストを変更できないこと
+	assert.Equal(s.T(), "forbidden", testChecklistItemService.CreateChecklistItem(todo.ID, dto.CreateChecklistItemRequest{Title: "test item 2"}, viewer.ID).ErrorType)
+	assert.Equal(s.T(), "forbidden", testChecklistItemService.UpdateChecklistItem(todo.ID, item.ID, dto.UpdateChecklistItemRequest{Title: "test item 1", Done: true}, viewer.ID).ErrorType)
+	assert.Equal(s.T(), "forbidden", testChecklistItemService.ReorderChecklistItems(todo.ID, dto.ReorderChecklistItemsRequest{ItemIDs: []int{item.ID}}, viewer.ID).ErrorType)
+	assert.Equal(s.T(), "forbidden", testChecklistItemService.DeleteChecklistItem(todo.ID, item.ID, viewer.ID).ErrorType)
+	// NOTE: 担当者は完了状態の切り替えのみできること
+	toggled := testChecklistItemService.UpdateChecklistItem(todo.ID, item.ID, dto.UpdateChecklistItemRequest{Title: "test item 1", Done: true}, assignee.ID)
+	assert.Nil(s.T(), toggled.Error)
+	assert.True(s.T(), toggled.ChecklistItem.Done)
+	renamed := testChecklistItemService.UpdateChecklistItem(todo.ID, item.ID, dto.UpdateChecklistItemRequest{Title: "test updated item 1", Done: true}, assignee.ID)
+	assert.Equal(s.T(), "forbidden", renamed.ErrorType)
+}
+
+func TestChecklistItemService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestChecklistItemServiceSuite))
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// NOTE: 投稿者以外がコメントを編集・削除しようとした場合のエラー
+var errCommentAuthorOnly = errors.New("only the author of the comment can perform this operation")
+
+type CommentService interface {
+	CreateComment(todoId int, requestParams dto.CreateCommentRequest, userId int) *dto.CreateCommentResponse
+	FetchCommentsList(todoId int, userId int) *dto.CommentsListResponse
+	UpdateComment(todoId int, id int, requestParams dto.UpdateCommentRequest, userId int) *dto.UpdateCommentResponse
+	DeleteComment(todoId int, id int, userId int) *dto.DeleteCommentResponse
+}
+
+type commentService struct {
+	commentRepository repositories.CommentRepository
+	authorizer        todoAuthorizer
+	publisher         notificationPublisher
+}
+
+func NewCommentService(todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, commentRepository repositories.CommentRepository, notificationRepository repositories.NotificationRepository, userSettingRepository repositories.UserSettingRepository) CommentService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	publisher := notificationPublisher{notificationRepository, userSettingRepository}
+	return &commentService{commentRepository, authorizer, publisher}
+}
+
+func (cs *commentService) CreateComment(todoId int, requestParams dto.CreateCommentRequest, userId int) *dto.CreateCommentResponse {
+	todo := models.Todo{}
+	if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionComment); err != nil {
+		return &dto.CreateCommentResponse{Comment: models.Comment{}, Error: err, ErrorType: errorType}
+	}
+
+	comment := models.Comment{}
+	comment.TodoID = todo.ID
+	comment.UserID = userId
+	comment.Body = requestParams.Body
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(comment)
+	if validationErrors != nil {
+		return &dto.CreateCommentResponse{Comment: comment, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	// NOTE: Create処理
+	if err := cs.commentRepository.CreateComment(&comment); err != nil {
+		return &dto.CreateCommentResponse{Comment: comment, Error: err, ErrorType: "internalServerError"}
+	}
+	cs.notifyComment(todo, comment)
+	return &dto.CreateCommentResponse{Comment: comment, Error: nil, ErrorType: ""}
+}
+
+func (cs *commentService) FetchCommentsList(todoId int, userId int) *dto.CommentsListResponse {
+	todo := models.Todo{}
+	if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.CommentsListResponse{Comments: []models.Comment{}, Error: err, ErrorType: errorType}
+	}
+
+	comments := []models.Comment{}
+	if err := cs.commentRepository.GetComments(&comments, todo.ID); err != nil {
+		return &dto.CommentsListResponse{Comments: []models.Comment{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.CommentsListResponse{Comments: comments, Error: nil, ErrorType: ""}
+}
+
+func (cs *commentService) UpdateComment(todoId int, id int, requestParams dto.UpdateCommentRequest, userId int) *dto.UpdateCommentResponse {
+	todo := models.Todo{}
+	if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.UpdateCommentResponse{Comment: models.Comment{}, Error: err, ErrorType: errorType}
+	}
+	comment := models.Comment{}
+	if err := cs.commentRepository.GetCommentById(&comment, id, todo.ID); err != nil {
+		return &dto.UpdateCommentResponse{Comment: models.Comment{}, Error: err, ErrorType: "notFound"}
+	}
+	if comment.UserID != userId {
+		return &dto.UpdateCommentResponse{Comment: comment, Error: errCommentAuthorOnly, ErrorType: "forbidden"}
+	}
+
+	comment.Body = requestParams.Body
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(comment)
+	if validationErrors != nil {
+		return &dto.UpdateCommentResponse{Comment: comment, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	// NOTE: Update処理
+	if err := cs.commentRepository.UpdateComment(&comment); err != nil {
+		return &dto.UpdateCommentResponse{Comment: comment, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.UpdateCommentResponse{Comment: comment, Error: nil, ErrorType: ""}
+}
+
+func (cs *commentService) DeleteComment(todoId int, id int, userId int) *dto.DeleteCommentResponse {
+	todo := models.Todo{}
+	if errorType, err := cs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.DeleteCommentResponse{Error: err, ErrorType: errorType}
+	}
+	comment := models.Comment{}
+	if err := cs.commentRepository.GetCommentById(&comment, id, todo.ID); err != nil {
+		return &dto.DeleteCommentResponse{Error: err, ErrorType: "notFound"}
+	}
+	if comment.UserID != userId {
+		return &dto.DeleteCommentResponse{Error: errCommentAuthorOnly, ErrorType: "forbidden"}
+	}
+
+	if err := cs.commentRepository.DeleteComment(&comment); err != nil {
+		return &dto.DeleteCommentResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.DeleteCommentResponse{Error: nil, ErrorType: ""}
+}
+
+// NOTE: 所有者・担当者・共有先のユーザにコメントを通知する(共有先を取得できない場合は所有者・担当者のみに通知する)
+func (cs *commentService) notifyComment(todo models.Todo, comment models.Comment) {
+	recipientIds := []int{todo.UserID}
+	if todo.AssigneeID != nil {
+		recipientIds = append(recipientIds, *todo.AssigneeID)
+	}
+	shares := []models.TodoShare{}
+	_ = cs.authorizer.todoShareRepository.GetTodoShares(&shares, todo.ID)
+	for _, share := range shares {
+		recipientIds = append(recipientIds, share.UserID)
+	}
+	cs.publisher.publish(models.NotificationTypeCommented, recipientIds, comment.UserID, todo, "New comment on: "+todo.Title, comment.Body)
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestCommentServiceSuite struct {
+	WithDbSuite
+}
+
+var testCommentService CommentService
+
+func (s *TestCommentServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	commentRepository := repositories.NewCommentRepository(DbCon)
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+	testCommentService = NewCommentService(todoRepository, todoShareRepository, commentRepository, notificationRepository, userSettingRepository)
+}
+
+func (s *TestCommentServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment() {
+	result := testCommentService.CreateComment(todo.ID, dto.CreateCommentRequest{Body: "test comment 1"}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), user.ID, result.Comment.UserID)
+	comments := testCommentService.FetchCommentsList(todo.ID, user.ID)
+	assert.Len(s.T(), comments.Comments, 1)
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment_Notification() {
+	assignee := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "assignee@example.com"}).(*models.User)
+	editor := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "editor@example.com"}).(*models.User)
+	muted := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "muted@example.com"}).(*models.User)
+	for _, u := range []*models.User{assignee, editor, muted} {
+		if err := DbCon.Create(&u).Error; err != nil {
+			s.T().Fatalf("failed to create test user %v", err)
+		}
+	}
+	DbCon.Model(&todo).Update("assignee_id", assignee.ID)
+	shares := []models.TodoShare{{TodoID: todo.ID, UserID: editor.ID, Role: models.TodoShareRoleEditor}, {TodoID: todo.ID, UserID: muted.ID, Role: models.TodoShareRoleViewer}}
+	if err := DbCon.Create(&shares).Error; err != nil {
+		s.T().Fatalf("failed to create test todo shares %v", err)
+	}
+	if err := DbCon.Create(&models.UserSetting{UserID: muted.ID, MutedNotificationTypes: []string{models.NotificationTypeCommented}}).Error; err != nil {
+		s.T().Fatalf("failed to create test setting %v", err)
+	}
+
+	result := testCommentService.CreateComment(todo.ID, dto.CreateCommentRequest{Body: "test comment 1"}, editor.ID)
+
+	// NOTE: 投稿者と受け取らない設定のユーザを除く関係者に通知されること
+	assert.Nil(s.T(), result.Error)
+	notifications := []models.Notification{}
+	DbCon.Order("user_id ASC").Find(&notifications)
+	assert.Len(s.T(), notifications, 2)
+	assert.Equal(s.T(), user.ID, notifications[0].UserID)
+	assert.Equal(s.T(), assignee.ID, notifications[1].UserID)
+	assert.Equal(s.T(), models.NotificationTypeCommented, notifications[0].Type)
+	assert.Equal(s.T(), "test comment 1", notifications[0].Body)
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment_NotificationError() {
+	editor := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "editor@example.com"}).(*models.User)
+	if err := DbCon.Create(&editor).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: editor.ID, Role: models.TodoShareRoleEditor}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+	mockNotificationRepository := new(MockNotificationRepository)
+	mockNotificationRepository.On("CreateNotification", mock.Anything).Return(errors.New("db error"))
+	commentService := NewCommentService(repositories.NewTodoRepository(DbCon), repositories.NewTodoShareRepository(DbCon), repositories.NewCommentRepository(DbCon), mockNotificationRepository, repositories.NewUserSettingRepository(DbCon))
+
+	result := commentService.CreateComment(todo.ID, dto.CreateCommentRequest{Body: "test comment 1"}, editor.ID)
+
+	// NOTE: 通知の記録に失敗してもコメントの投稿は成功とすること
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+	mockNotificationRepository.AssertCalled(s.T(), "CreateNotification", mock.Anything)
+	assert.Len(s.T(), commentService.FetchCommentsList(todo.ID, user.ID).Comments, 1)
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment_ValidationError() {
+	result := testCommentService.CreateComment(todo.ID, dto.CreateCommentRequest{Body: ""}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
+func (s *TestCommentServiceSuite) TestCreateComment_Permissions() {
+	viewer := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "viewer@example.com"}).(*models.User)
+	stranger := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "stranger@example.com"}).(*models.User)
+	for _, u := range []*models.User{viewer, stranger} {
+		if err := DbCon.Create(&u).Error; err != nil {
+			s.T().Fatalf("failed to create test user %v", err)
+		}
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: viewer.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	// NOTE: 閲覧者はコメントを参照できるが投稿はできないこと
+	assert.Nil(s.T(), testCommentService.FetchCommentsList(todo.ID, viewer.ID).Error)
+	assert.Equal(s.T(), "forbidden", testCommentService.CreateComment(todo.ID, dto.CreateCommentRequest{Body: "test comment 1"}, viewer.ID).ErrorType)
+	assert.Equal(s.T(), "notFound", testCommentService.FetchCommentsList(todo.ID, stranger.ID).ErrorType)
+}
+
+func (s *TestCommentServiceSuite) TestUpdateComment_AuthorOnly() {
+	editor := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "editor@example.com"}).(*models.User)
+	if err := DbCon.Create(&editor).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: editor.ID, Role: models.TodoShareRoleEditor}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+	created := testCommentService.CreateComment(todo.ID, dto.CreateCommentRequest{Body: "test comment 1"}, editor.ID)
+
+	// NOTE: Todoの作成者であっても他人のコメントは編集・削除できないこと
+	updated := testCommentService.UpdateComment(todo.ID, created.Comment.ID, dto.UpdateCommentRequest{Body: "test updated comment 1"}, user.ID)
+	deleted := testCommentService.DeleteComment(todo.ID, created.Comment.ID, user.ID)
+	assert.Equal(s.T(), "forbidden", updated.ErrorType)
+	assert.Equal(s.T(), "forbidden", deleted.ErrorType)
+
+	authorUpdated := testCommentService.UpdateComment(todo.ID, created.Comment.ID, dto.UpdateCommentRequest{Body: "test updated comment 1"}, editor.ID)
+	authorDeleted := testCommentService.DeleteComment(todo.ID, created.Comment.ID, editor.ID)
+	assert.Nil(s.T(), authorUpdated.Error)
+	assert.Equal(s.T(), "test updated comment 1", authorUpdated.Comment.Body)
+	assert.Nil(s.T(), authorDeleted.Error)
+}
+
+func TestCommentService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestCommentServiceSuite))
+}
@@ -0,0 +1,40 @@
+package services
+
+import (
+	"app/models"
+	"app/repositories"
+	"errors"
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+// NOTE: Todoに関するイベントをアプリ内通知として記録する(複数のサービスから利用する)
+type notificationPublisher struct {
+	notificationRepository repositories.NotificationRepository
+	userSettingRepository  repositories.UserSettingRepository
+}
+
+// NOTE: 操作したユーザ自身と、受け取らない設定にしているユーザには通知しない
+// NOTE: 元の操作は確定済みのため、通知の記録に失敗しても操作自体は成功として扱う(クライアントの再試行で重複させない)
+func (np notificationPublisher) publish(notificationType string, recipientIds []int, actorId int, todo models.Todo, title string, body string) {
+	notifiedIds := []int{}
+	for _, recipientId := range recipientIds {
+		if recipientId == actorId || slices.Contains(notifiedIds, recipientId) {
+			continue
+		}
+		notifiedIds = append(notifiedIds, recipientId)
+
+		setting := models.UserSetting{}
+		err := np.userSettingRepository.GetUserSetting(&setting, recipientId)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if !setting.NotificationEnabled(notificationType) {
+			continue
+		}
+
+		notification := models.Notification{UserID: recipientId, ActorID: &actorId, Type: notificationType, TodoID: &todo.ID, Title: title, Body: body}
+		_ = np.notificationRepository.CreateNotification(&notification)
+	}
+}
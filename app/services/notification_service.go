@@ -0,0 +1,67 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type NotificationService interface {
+	FetchNotificationsList(requestParams dto.NotificationsListRequest, userId int) *dto.NotificationsListResponse
+	ReadNotification(id int, userId int) *dto.ReadNotificationResponse
+	ReadAllNotifications(userId int) *dto.ReadAllNotificationsResponse
+}
+
+type notificationService struct {
+	notificationRepository repositories.NotificationRepository
+}
+
+func NewNotificationService(notificationRepository repositories.NotificationRepository) NotificationService {
+	return &notificationService{notificationRepository}
+}
+
+func (ns *notificationService) FetchNotificationsList(requestParams dto.NotificationsListRequest, userId int) *dto.NotificationsListResponse {
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(requestParams)
+	if validationErrors != nil {
+		return &dto.NotificationsListResponse{Notifications: []models.Notification{}, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	notifications := []models.Notification{}
+	if err := ns.notificationRepository.GetNotifications(&notifications, userId, requestParams.UnreadOnly, requestParams.Limit, requestParams.Offset); err != nil {
+		return &dto.NotificationsListResponse{Notifications: []models.Notification{}, Error: err, ErrorType: "internalServerError"}
+	}
+	total, err := ns.notificationRepository.CountNotifications(userId, requestParams.UnreadOnly)
+	if err != nil {
+		return &dto.NotificationsListResponse{Notifications: []models.Notification{}, Error: err, ErrorType: "internalServerError"}
+	}
+	unreadCount, err := ns.notificationRepository.CountNotifications(userId, true)
+	if err != nil {
+		return &dto.NotificationsListResponse{Notifications: []models.Notification{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.NotificationsListResponse{Notifications: notifications, Total: total, UnreadCount: unreadCount, Error: nil, ErrorType: ""}
+}
+
+func (ns *notificationService) ReadNotification(id int, userId int) *dto.ReadNotificationResponse {
+	notification := models.Notification{}
+	if err := ns.notificationRepository.GetNotificationById(&notification, id, userId); err != nil {
+		return &dto.ReadNotificationResponse{Notification: models.Notification{}, Error: err, ErrorType: "notFound"}
+	}
+
+	if err := ns.notificationRepository.MarkNotificationRead(&notification, time.Now()); err != nil {
+		return &dto.ReadNotificationResponse{Notification: notification, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.ReadNotificationResponse{Notification: notification, Error: nil, ErrorType: ""}
+}
+
+func (ns *notificationService) ReadAllNotifications(userId int) *dto.ReadAllNotificationsResponse {
+	count, err := ns.notificationRepository.MarkAllNotificationsRead(userId, time.Now())
+	if err != nil {
+		return &dto.ReadAllNotificationsResponse{ReadCount: 0, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.ReadAllNotificationsResponse{ReadCount: count, Error: nil, ErrorType: ""}
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestNotificationServiceSuite struct {
+	WithDbSuite
+}
+
+var testNotificationService NotificationService
+
+func (s *TestNotificationServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・通知の作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	now := time.Now()
+	notifications := []models.Notification{
+		{UserID: user.ID, Type: models.NotificationTypeAssigned, Title: "test notification 1", CreatedAt: now.Add(-2 * time.Minute)},
+		{UserID: user.ID, Type: models.NotificationTypeShared, Title: "test notification 2", CreatedAt: now.Add(-time.Minute), ReadAt: &now},
+		{UserID: user.ID, Type: models.NotificationTypeCommented, Title: "test notification 3", CreatedAt: now},
+	}
+	if err := DbCon.Create(&notifications).Error; err != nil {
+		s.T().Fatalf("failed to create test notifications %v", err)
+	}
+
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+	testNotificationService = NewNotificationService(notificationRepository)
+}
+
+func (s *TestNotificationServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestNotificationServiceSuite) TestFetchNotificationsList() {
+	result := testNotificationService.FetchNotificationsList(dto.NotificationsListRequest{Limit: 2, Offset: 0}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Notifications, 2)
+	assert.Equal(s.T(), "test notification 3", result.Notifications[0].Title)
+	assert.Equal(s.T(), int64(3), result.Total)
+	assert.Equal(s.T(), int64(2), result.UnreadCount)
+
+	// NOTE: 未読のみに絞り込んだ場合は件数も未読の件数となること
+	unread := testNotificationService.FetchNotificationsList(dto.NotificationsListRequest{UnreadOnly: true, Limit: 20, Offset: 0}, user.ID)
+	assert.Len(s.T(), unread.Notifications, 2)
+	assert.Equal(s.T(), int64(2), unread.Total)
+}
+
+func (s *TestNotificationServiceSuite) TestFetchNotificationsList_ValidationError() {
+	result := testNotificationService.FetchNotificationsList(dto.NotificationsListRequest{Limit: 101, Offset: 0}, user.ID)
+
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
+func (s *TestNotificationServiceSuite) TestReadNotification() {
+	notification := models.Notification{}
+	DbCon.Where("title = ?", "test notification 1").First(&notification)
+
+	result := testNotificationService.ReadNotification(notification.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.NotNil(s.T(), result.Notification.ReadAt)
+	fetched := testNotificationService.FetchNotificationsList(dto.NotificationsListRequest{Limit: 20, Offset: 0}, user.ID)
+	assert.Equal(s.T(), int64(1), fetched.UnreadCount)
+}
+
+func (s *TestNotificationServiceSuite) TestReadNotification_NotFound() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	notification := models.Notification{}
+	DbCon.Where("title = ?", "test notification 1").First(&notification)
+
+	// NOTE: 他のユーザの通知は既読にできないこと
+	result := testNotificationService.ReadNotification(notification.ID, otherUser.ID)
+
+	assert.Equal(s.T(), "notFound", result.ErrorType)
+}
+
+func (s *TestNotificationServiceSuite) TestReadAllNotifications() {
+	result := testNotificationService.ReadAllNotifications(user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), int64(2), result.ReadCount)
+	fetched := testNotificationService.FetchNotificationsList(dto.NotificationsListRequest{Limit: 20, Offset: 0}, user.ID)
+	assert.Equal(s.T(), int64(0), fetched.UnreadCount)
+}
+
+func TestNotificationService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestNotificationServiceSuite))
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/notifier"
+	"app/repositories"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+// NOTE: 配信中に停止した場合も、この時間が経過すれば別のプロセスが再度配信する
+const reminderClaimDuration = 5 * time.Minute
+
+var errReminderDeliveryFailed = errors.New("delivery failed")
+
+// NOTE: 設定したユーザが共有の解除などでTodoを参照できなくなった場合のエラー
+var errReminderTodoNotAccessible = errors.New("todo is no longer accessible")
+
+type ReminderService interface {
+	CreateReminder(todoId int, requestParams dto.CreateReminderRequest, userId int) *dto.CreateReminderResponse
+	FetchRemindersList(todoId int, userId int) *dto.RemindersListResponse
+	DeleteReminder(todoId int, id int, userId int) *dto.DeleteReminderResponse
+	DeliverDueReminders() *dto.DeliverRemindersResponse
+}
+
+type reminderService struct {
+	reminderRepository    repositories.ReminderRepository
+	userSettingRepository repositories.UserSettingRepository
+	notifiers             map[string]notifier.Notifier
+	authorizer            todoAuthorizer
+}
+
+func NewReminderService(todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, reminderRepository repositories.ReminderRepository, userSettingRepository repositories.UserSettingRepository, notifiers map[string]notifier.Notifier) ReminderService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	return &reminderService{reminderRepository, userSettingRepository, notifiers, authorizer}
+}
+
+// NOTE: リマインダーは設定したユーザ本人にのみ通知するため、参照権限があれば設定できる
+func (rs *reminderService) CreateReminder(todoId int, requestParams dto.CreateReminderRequest, userId int) *dto.CreateReminderResponse {
+	todo := models.Todo{}
+	if errorType, err := rs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.CreateReminderResponse{Reminder: models.Reminder{}, Error: err, ErrorType: errorType}
+	}
+
+	reminder := models.Reminder{}
+	reminder.TodoID = todo.ID
+	reminder.UserID = userId
+	reminder.RemindAt = requestParams.RemindAt
+	reminder.OffsetMinutes = requestParams.OffsetMinutes
+	reminder.Channels = requestParams.Channels
+	if len(reminder.Channels) == 0 {
+		reminder.Channels = []string{models.ReminderChannelInApp}
+	}
+	reminder.DeliveredChannels = []string{}
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(reminder)
+	if validationErrors != nil {
+		return &dto.CreateReminderResponse{Reminder: reminder, Error: validationErrors, ErrorType: "validationError"}
+	}
+	if reminder.RemindAt != nil && !reminder.RemindAt.After(time.Now()) {
+		return &dto.CreateReminderResponse{Reminder: reminder, Error: fmt.Errorf("remind_at must be in the future"), ErrorType: "badRequest"}
+	}
+	if reminder.OffsetMinutes != nil && todo.DueAt == nil {
+		return &dto.CreateReminderResponse{Reminder: reminder, Error: fmt.Errorf("offset_minutes requires the todo to have a due date"), ErrorType: "badRequest"}
+	}
+
+	// NOTE: Create処理
+	if err := rs.reminderRepository.CreateReminder(&reminder); err != nil {
+		return &dto.CreateReminderResponse{Reminder: reminder, Error: err, ErrorType: "internalServerError"}
+	}
+	reminder.FireAt = reminder.NextFireAt(todo.DueAt)
+	return &dto.CreateReminderResponse{Reminder: reminder, Error: nil, ErrorType: ""}
+}
+
+func (rs *reminderService) FetchRemindersList(todoId int, userId int) *dto.RemindersListResponse {
+	todo := models.Todo{}
+	if errorType, err := rs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.RemindersListResponse{Reminders: []models.Reminder{}, Error: err, ErrorType: errorType}
+	}
+
+	reminders := []models.Reminder{}
+	if err := rs.reminderRepository.GetReminders(&reminders, todo.ID, userId); err != nil {
+		return &dto.RemindersListResponse{Reminders: []models.Reminder{}, Error: err, ErrorType: "internalServerError"}
+	}
+	for i := range reminders {
+		reminders[i].FireAt = reminders[i].NextFireAt(todo.DueAt)
+	}
+	return &dto.RemindersListResponse{Reminders: reminders, Error: nil, ErrorType: ""}
+}
+
+func (rs *reminderService) DeleteReminder(todoId int, id int, userId int) *dto.DeleteReminderResponse {
+	todo := models.Todo{}
+	if errorType, err := rs.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.DeleteReminderResponse{Error: err, ErrorType: errorType}
+	}
+	reminder := models.Reminder{}
+	if err := rs.reminderRepository.GetReminderById(&reminder, id, todo.ID, userId); err != nil {
+		return &dto.DeleteReminderResponse{Error: err, ErrorType: "notFound"}
+	}
+
+	if err := rs.reminderRepository.DeleteReminder(&reminder); err != nil {
+		return &dto.DeleteReminderResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.DeleteReminderResponse{Error: nil, ErrorType: ""}
+}
+
+// NOTE: 通知日時を過ぎた未送信のリマインダーを配信する(再起動前に送れなかったものも対象となる)
+func (rs *reminderService) DeliverDueReminders() *dto.DeliverRemindersResponse {
+	now := time.Now()
+	reminders := []models.Reminder{}
+	if err := rs.reminderRepository.GetDueReminders(&reminders, now); err != nil {
+		return &dto.DeliverRemindersResponse{Error: err, ErrorType: "internalServerError"}
+	}
+
+	sentCount, failedCount := 0, 0
+	for i := range reminders {
+		reminder := &reminders[i]
+		fireAt := reminder.NextFireAt(reminder.Todo.DueAt)
+		if fireAt == nil || fireAt.After(now) {
+			continue
+		}
+		claimed, err := rs.reminderRepository.ClaimReminder(reminder, now, now.Add(reminderClaimDuration))
+		if err != nil {
+			return &dto.DeliverRemindersResponse{SentCount: sentCount, FailedCount: failedCount, Error: err, ErrorType: "internalServerError"}
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := rs.deliverReminder(reminder, now); err != nil {
+			return &dto.DeliverRemindersResponse{SentCount: sentCount, FailedCount: failedCount, Error: err, ErrorType: "internalServerError"}
+		}
+		if reminder.SentAt != nil {
+			sentCount++
+		} else {
+			failedCount++
+		}
+	}
+	return &dto.DeliverRemindersResponse{SentCount: sentCount, FailedCount: failedCount, Error: nil, ErrorType: ""}
+}
+
+// NOTE: 未配信のチャネルに送信し、失敗したチャネルは間隔を空けて再試行する
+func (rs *reminderService) deliverReminder(reminder *models.Reminder, now time.Time) error {
+	// NOTE: 参照できなくなったTodoの内容は送らず、再試行せずに失敗とする
+	todo := models.Todo{}
+	errorType, err := rs.authorizer.authorizeTodo(&todo, reminder.TodoID, reminder.UserID, todoPermissionRead)
+	if errorType == "internalServerError" {
+		return err
+	}
+	if err != nil {
+		reminder.Attempts++
+		reminder.ClaimedUntil = nil
+		reminder.FailedAt = &now
+		reminder.LastError = errReminderTodoNotAccessible.Error()
+		return rs.reminderRepository.UpdateReminderDelivery(reminder)
+	}
+
+	message, err := rs.reminderMessage(*reminder)
+	if err != nil {
+		return err
+	}
+
+	deliveryErrors := []error{}
+	for _, channel := range reminder.Channels {
+		if slices.Contains(reminder.DeliveredChannels, channel) {
+			continue
+		}
+		channelNotifier, ok := rs.notifiers[channel]
+		if !ok {
+			deliveryErrors = append(deliveryErrors, fmt.Errorf("%s: %w", channel, notifier.ErrChannelNotConfigured))
+			continue
+		}
+		if err := channelNotifier.Notify(message); err != nil {
+			deliveryErrors = append(deliveryErrors, fmt.Errorf("%s: %w", channel, reminderDeliveryError(err)))
+			continue
+		}
+		reminder.DeliveredChannels = append(reminder.DeliveredChannels, channel)
+	}
+
+	reminder.Attempts++
+	reminder.ClaimedUntil = nil
+	if len(deliveryErrors) == 0 {
+		reminder.SentAt = &now
+		reminder.LastError = ""
+	} else {
+		reminder.LastError = errors.Join(deliveryErrors...).Error()
+		if reminder.Attempts >= models.ReminderMaxAttempts {
+			reminder.FailedAt = &now
+		} else {
+			retryAt := now.Add(time.Duration(reminder.Attempts*reminder.Attempts) * time.Minute)
+			reminder.ClaimedUntil = &retryAt
+		}
+	}
+	return rs.reminderRepository.UpdateReminderDelivery(reminder)
+}
+
+// NOTE: 送信先の応答や通信エラーの内容は利用者に見せないため、既知のエラー以外は汎用のエラーとして記録する
+func reminderDeliveryError(err error) error {
+	for _, knownErr := range []error{notifier.ErrChannelNotConfigured, notifier.ErrWebhookURLNotAllowed} {
+		if errors.Is(err, knownErr) {
+			return knownErr
+		}
+	}
+	return errReminderDeliveryFailed
+}
+
+func (rs *reminderService) reminderMessage(reminder models.Reminder) (notifier.Message, error) {
+	setting := models.UserSetting{}
+	if err := rs.userSettingRepository.GetUserSetting(&setting, reminder.UserID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return notifier.Message{}, err
+	}
+
+	body := fmt.Sprintf("Reminder for \"%s\"", reminder.Todo.Title)
+	if reminder.Todo.DueAt != nil {
+		body = fmt.Sprintf("\"%s\" is due at %s", reminder.Todo.Title, reminder.Todo.DueAt.Format(time.RFC3339))
+	}
+	return notifier.Message{
+		Type:       models.NotificationTypeReminder,
+		UserID:     reminder.UserID,
+		Email:      reminder.User.Email,
+		WebhookURL: setting.WebhookURL,
+		TodoID:     reminder.TodoID,
+		Subject:    "Reminder: " + reminder.Todo.Title,
+		Body:       body,
+	}, nil
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/notifier"
+	"app/repositories"
+	"app/test/factories"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// NOTE: 送信した通知を記録する(errを設定すると送信に失敗する)
+type fakeNotifier struct {
+	messages []notifier.Message
+	err      error
+}
+
+func (fn *fakeNotifier) Notify(message notifier.Message) error {
+	if fn.err != nil {
+		return fn.err
+	}
+	fn.messages = append(fn.messages, message)
+	return nil
+}
+
+type TestReminderServiceSuite struct {
+	WithDbSuite
+	emailNotifier *fakeNotifier
+	inAppNotifier *fakeNotifier
+}
+
+var testReminderService ReminderService
+
+func (s *TestReminderServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	s.emailNotifier = &fakeNotifier{}
+	s.inAppNotifier = &fakeNotifier{}
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	reminderRepository := repositories.NewReminderRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+	testReminderService = NewReminderService(todoRepository, todoShareRepository, reminderRepository, userSettingRepository, map[string]notifier.Notifier{
+		models.ReminderChannelEmail: s.emailNotifier,
+		models.ReminderChannelInApp: s.inAppNotifier,
+	})
+}
+
+func (s *TestReminderServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestReminderServiceSuite) createTodo(dueAt *time.Time) models.Todo {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID, DueAt: dueAt}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	return testTodo
+}
+
+func (s *TestReminderServiceSuite) TestCreateReminder_Offset() {
+	dueAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	testTodo := s.createTodo(&dueAt)
+	offsetMinutes := 60
+
+	result := testReminderService.CreateReminder(testTodo.ID, dto.CreateReminderRequest{OffsetMinutes: &offsetMinutes}, user.ID)
+
+	// NOTE: チャネルの省略時はアプリ内通知とし、通知日時は期日から算出すること
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), []string{models.ReminderChannelInApp}, result.Reminder.Channels)
+	assert.True(s.T(), dueAt.Add(-time.Hour).Equal(*result.Reminder.FireAt))
+}
+
+func (s *TestReminderServiceSuite) TestCreateReminder_Invalid() {
+	testTodo := s.createTodo(nil)
+	offsetMinutes := 60
+	pastRemindAt := time.Now().Add(-time.Hour)
+	futureRemindAt := time.Now().Add(time.Hour)
+
+	withoutDueAt := testReminderService.CreateReminder(testTodo.ID, dto.CreateReminderRequest{OffsetMinutes: &offsetMinutes}, user.ID)
+	assert.Equal(s.T(), "badRequest", withoutDueAt.ErrorType)
+
+	past := testReminderService.CreateReminder(testTodo.ID, dto.CreateReminderRequest{RemindAt: &pastRemindAt}, user.ID)
+	assert.Equal(s.T(), "badRequest", past.ErrorType)
+
+	both := testReminderService.CreateReminder(testTodo.ID, dto.CreateReminderRequest{RemindAt: &futureRemindAt, OffsetMinutes: &offsetMinutes}, user.ID)
+	assert.Equal(s.T(), "validationError", both.ErrorType)
+
+	neither := testReminderService.CreateReminder(testTodo.ID, dto.CreateReminderRequest{}, user.ID)
+	assert.Equal(s.T(), "validationError", neither.ErrorType)
+
+	invalidChannel := testReminderService.CreateReminder(testTodo.ID, dto.CreateReminderRequest{RemindAt: &futureRemindAt, Channels: []string{"sms"}}, user.ID)
+	assert.Equal(s.T(), "validationError", invalidChannel.ErrorType)
+}
+
+func (s *TestReminderServiceSuite) TestDeliverDueReminders() {
+	// NOTE: 期日の変更に追従して通知すること
+	dueAt := time.Now().Add(24 * time.Hour)
+	testTodo := s.createTodo(&dueAt)
+	offsetMinutes := 30
+	created := testReminderService.CreateReminder(testTodo.ID, dto.CreateReminderRequest{OffsetMinutes: &offsetMinutes, Channels: []string{models.ReminderChannelEmail, models.ReminderChannelInApp}}, user.ID)
+	notYet := testReminderService.DeliverDueReminders()
+	assert.Equal(s.T(), 0, notYet.SentCount)
+	DbCon.Model(&models.Todo{}).Where("id = ?", testTodo.ID).Update("due_at", time.Now().Add(10*time.Minute))
+
+	result := testReminderService.DeliverDueReminders()
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), 1, result.SentCount)
+	assert.Len(s.T(), s.emailNotifier.messages, 1)
+	assert.Equal(s.T(), "test@example.com", s.emailNotifier.messages[0].Email)
+	assert.Equal(s.T(), "Reminder: test title 1", s.inAppNotifier.messages[0].Subject)
+	reminders := testReminderService.FetchRemindersList(testTodo.ID, user.ID).Reminders
+	assert.Equal(s.T(), created.Reminder.ID, reminders[0].ID)
+	assert.NotNil(s.T(), reminders[0].SentAt)
+
+	// NOTE: 送信済みのリマインダーは再送しないこと
+	testReminderService.DeliverDueReminders()
+	assert.Len(s.T(), s.emailNotifier.messages, 1)
+}
+
+func (s *TestReminderServiceSuite) TestDeliverDueReminders_Retry() {
+	testTodo := s.createTodo(nil)
+	remindAt := time.Now().Add(-time.Minute)
+	reminder := models.Reminder{TodoID: testTodo.ID, UserID: user.ID, RemindAt: &remindAt, Channels: []string{models.ReminderChannelEmail, models.ReminderChannelInApp}, DeliveredChannels: []string{}}
+	if err := DbCon.Create(&reminder).Error; err != nil {
+		s.T().Fatalf("failed to create test reminder %v", err)
+	}
+	s.emailNotifier.err = errors.New("smtp error")
+
+	result := testReminderService.DeliverDueReminders()
+
+	// NOTE: 失敗したチャネルのみ再試行を待ち、再試行時に配信済みのチャネルへは送らないこと
+	assert.Equal(s.T(), 1, result.FailedCount)
+	failedReminder := models.Reminder{}
+	DbCon.First(&failedReminder, reminder.ID)
+	assert.Equal(s.T(), []string{models.ReminderChannelInApp}, failedReminder.DeliveredChannels)
+	// NOTE: 送信先のエラー内容は記録しないこと
+	assert.Equal(s.T(), "email: delivery failed", failedReminder.LastError)
+	assert.NotNil(s.T(), failedReminder.ClaimedUntil)
+
+	s.emailNotifier.err = nil
+	DbCon.Model(&models.Reminder{}).Where("id = ?", reminder.ID).Update("claimed_until", nil)
+	retried := testReminderService.DeliverDueReminders()
+
+	assert.Equal(s.T(), 1, retried.SentCount)
+	assert.Len(s.T(), s.emailNotifier.messages, 1)
+	assert.Len(s.T(), s.inAppNotifier.messages, 1)
+}
+
+func (s *TestReminderServiceSuite) TestDeliverDueReminders_GiveUp() {
+	testTodo := s.createTodo(nil)
+	remindAt := time.Now().Add(-time.Minute)
+	reminder := models.Reminder{TodoID: testTodo.ID, UserID: user.ID, RemindAt: &remindAt, Channels: []string{models.ReminderChannelWebhook}, DeliveredChannels: []string{}, Attempts: models.ReminderMaxAttempts - 1}
+	if err := DbCon.Create(&reminder).Error; err != nil {
+		s.T().Fatalf("failed to create test reminder %v", err)
+	}
+
+	result := testReminderService.DeliverDueReminders()
+
+	// NOTE: 上限回数に達した場合は失敗として再試行しないこと
+	assert.Equal(s.T(), 1, result.FailedCount)
+	failedReminder := models.Reminder{}
+	DbCon.First(&failedReminder, reminder.ID)
+	assert.NotNil(s.T(), failedReminder.FailedAt)
+	assert.Contains(s.T(), failedReminder.LastError, notifier.ErrChannelNotConfigured.Error())
+}
+
+func (s *TestReminderServiceSuite) TestDeliverDueReminders_AccessRevoked() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := s.createTodo(nil)
+	share := models.TodoShare{TodoID: testTodo.ID, UserID: collaborator.ID, Role: models.TodoShareRoleViewer}
+	if err := DbCon.Create(&share).Error; err != nil {
+		s.T().Fatalf("failed to create test share %v", err)
+	}
+	remindAt := time.Now().Add(time.Hour)
+	created := testReminderService.CreateReminder(testTodo.ID, dto.CreateReminderRequest{RemindAt: &remindAt, Channels: []string{models.ReminderChannelEmail}}, collaborator.ID)
+	assert.Nil(s.T(), created.Error)
+	DbCon.Delete(&share)
+	DbCon.Model(&models.Reminder{}).Where("id = ?", created.Reminder.ID).Update("remind_at", time.Now().Add(-time.Minute))
+
+	result := testReminderService.DeliverDueReminders()
+
+	// NOTE: 共有が解除されたユーザにはTodoの内容を送らず、失敗として再試行しないこと
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), 1, result.FailedCount)
+	assert.Len(s.T(), s.emailNotifier.messages, 0)
+	failedReminder := models.Reminder{}
+	DbCon.First(&failedReminder, created.Reminder.ID)
+	assert.NotNil(s.T(), failedReminder.FailedAt)
+	assert.Equal(s.T(), errReminderTodoNotAccessible.Error(), failedReminder.LastError)
+}
+
+func TestReminderService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestReminderServiceSuite))
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"app/dto"
+	"app/search"
+	"app/utils"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type SearchService interface {
+	Search(requestParams dto.SearchRequest, userId int) *dto.SearchResponse
+}
+
+type searchService struct {
+	searcher search.Searcher
+}
+
+func NewSearchService(searcher search.Searcher) SearchService {
+	return &searchService{searcher}
+}
+
+// NOTE: 参照できるTodo・コメントを関連度順に検索し、一致箇所をハイライトする
+func (ss *searchService) Search(requestParams dto.SearchRequest, userId int) *dto.SearchResponse {
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(requestParams)
+	if validationErrors != nil {
+		return &dto.SearchResponse{Hits: []dto.SearchHit{}, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	hits, err := ss.searcher.Search(search.Query{Keyword: requestParams.Query, UserID: userId, Limit: requestParams.Limit, Offset: requestParams.Offset})
+	if err != nil {
+		return &dto.SearchResponse{Hits: []dto.SearchHit{}, Error: err, ErrorType: "internalServerError"}
+	}
+
+	terms := search.Terms(requestParams.Query)
+	searchHits := []dto.SearchHit{}
+	for _, hit := range hits {
+		// NOTE: Todoの内容はMarkdownのため、記法を除いた文章から抜粋する
+		body := hit.Body
+		if hit.Type == search.HitTypeTodo {
+			body = utils.MarkdownToPlainText(body)
+		}
+		searchHits = append(searchHits, dto.SearchHit{
+			Type:      hit.Type,
+			TodoID:    hit.TodoID,
+			CommentID: hit.CommentID,
+			Title:     search.Highlight(hit.Title, terms),
+			Snippet:   search.Snippet(body, terms),
+			Score:     hit.Score,
+		})
+	}
+	return &dto.SearchResponse{Hits: searchHits, Error: nil, ErrorType: ""}
+}
@@ -0,0 +1,49 @@
+package services
+
+import (
+	"app/dto"
+	"app/search"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestSearchServiceSuite struct {
+	suite.Suite
+	searcher *search.MemorySearcher
+}
+
+func (s *TestSearchServiceSuite) SetupTest() {
+	commentId := 10
+	s.searcher = search.NewMemorySearcher()
+	s.searcher.Index(
+		search.Document{Type: search.HitTypeTodo, TodoID: 1, Title: "Weekly report", Body: "# Draft\n\nCollect **report** numbers", ReaderIDs: []int{1}},
+		search.Document{Type: search.HitTypeComment, TodoID: 1, CommentID: &commentId, Title: "Weekly report", Body: "The report is <late>", ReaderIDs: []int{1}},
+	)
+}
+
+func (s *TestSearchServiceSuite) TestSearch() {
+	ss := NewSearchService(s.searcher)
+	result := ss.Search(dto.SearchRequest{Query: "Report", Limit: 20}, 1)
+
+	// NOTE: Todoの内容はMarkdownの記法を除いて抜粋し、HTMLはエスケープすること
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Hits, 2)
+	assert.Equal(s.T(), "Weekly <mark>report</mark>", result.Hits[0].Title)
+	assert.Equal(s.T(), "Draft Collect <mark>report</mark> numbers", result.Hits[0].Snippet)
+	assert.Equal(s.T(), search.HitTypeComment, result.Hits[1].Type)
+	assert.Equal(s.T(), "The <mark>report</mark> is &lt;late&gt;", result.Hits[1].Snippet)
+}
+
+func (s *TestSearchServiceSuite) TestSearch_ValidationError() {
+	ss := NewSearchService(s.searcher)
+
+	assert.Equal(s.T(), "validationError", ss.Search(dto.SearchRequest{Query: "", Limit: 20}, 1).ErrorType)
+	assert.Equal(s.T(), "validationError", ss.Search(dto.SearchRequest{Query: "report", Limit: 101}, 1).ErrorType)
+}
+
+func TestSearchService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestSearchServiceSuite))
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
+type TagService interface {
+	SetTodoTags(id int, requestParams dto.SetTodoTagsRequest, userId int) *dto.SetTodoTagsResponse
+	FetchTagsList(userId int) *dto.TagsListResponse
+}
+
+type tagService struct {
+	tagRepository repositories.TagRepository
+	authorizer    todoAuthorizer
+}
+
+func NewTagService(todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, tagRepository repositories.TagRepository) TagService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	return &tagService{tagRepository, authorizer}
+}
+
+func (ts *tagService) SetTodoTags(id int, requestParams dto.SetTodoTagsRequest, userId int) *dto.SetTodoTagsResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionEdit); err != nil {
+		return &dto.SetTodoTagsResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	names, err := normalizeTagNames(requestParams.Tags)
+	if err != nil {
+		return &dto.SetTodoTagsResponse{Todo: todo, Error: err, ErrorType: "badRequest"}
+	}
+
+	if err := ts.tagRepository.ReplaceTodoTags(&todo, names); err != nil {
+		return &dto.SetTodoTagsResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.SetTodoTagsResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+func (ts *tagService) FetchTagsList(userId int) *dto.TagsListResponse {
+	names := []string{}
+	if err := ts.tagRepository.GetTagNames(&names, userId); err != nil {
+		return &dto.TagsListResponse{Tags: []string{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TagsListResponse{Tags: names, Error: nil, ErrorType: ""}
+}
+
+// NOTE: タグ名を正規化し、重複を除いた上で件数・長さを検証する
+func normalizeTagNames(tags []string) ([]string, error) {
+	names := []string{}
+	for _, tag := range tags {
+		name := models.NormalizeTagName(tag)
+		if name == "" {
+			return nil, fmt.Errorf("tag name must not be empty")
+		}
+		if utf8.RuneCountInString(name) > models.TodoTagMaxLength {
+			return nil, fmt.Errorf("tag name must be at most %d characters", models.TodoTagMaxLength)
+		}
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	if len(names) > models.TodoTagMaxCount {
+		return nil, fmt.Errorf("a todo can have at most %d tags", models.TodoTagMaxCount)
+	}
+	slices.Sort(names)
+	return names, nil
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTagServiceSuite struct {
+	WithDbSuite
+}
+
+var testTagService TagService
+
+func (s *TestTagServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	tagRepository := repositories.NewTagRepository(DbCon)
+	testTagService = NewTagService(todoRepository, todoShareRepository, tagRepository)
+}
+
+func (s *TestTagServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTagServiceSuite) TestSetTodoTags() {
+	// NOTE: 正規化と重複の除去が行われること
+	result := testTagService.SetTodoTags(todo.ID, dto.SetTodoTagsRequest{Tags: []string{"#Work", " home ", "work"}}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), []string{"home", "work"}, result.Todo.Tags)
+	assert.Equal(s.T(), []string{"home", "work"}, testTagService.FetchTagsList(user.ID).Tags)
+}
+
+func (s *TestTagServiceSuite) TestSetTodoTags_Invalid() {
+	for _, tags := range [][]string{{"#"}, {strings.Repeat("a", 51)}} {
+		result := testTagService.SetTodoTags(todo.ID, dto.SetTodoTagsRequest{Tags: tags}, user.ID)
+
+		assert.NotNil(s.T(), result.Error)
+		assert.Equal(s.T(), "badRequest", result.ErrorType)
+	}
+}
+
+func (s *TestTagServiceSuite) TestSetTodoTags_Permissions() {
+	viewer := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "viewer@example.com"}).(*models.User)
+	if err := DbCon.Create(&viewer).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: viewer.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	result := testTagService.SetTodoTags(todo.ID, dto.SetTodoTagsRequest{Tags: []string{"home"}}, viewer.ID)
+
+	assert.Equal(s.T(), "forbidden", result.ErrorType)
+}
+
+func TestTagService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTagServiceSuite))
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+// NOTE: タイマーはユーザごとに1つのみ計測できる
+var errTimerAlreadyRunning = errors.New("another timer is already running")
+
+var errTimerNotRunning = errors.New("no timer is running for this todo")
+
+type TimeEntryService interface {
+	StartTimer(todoId int, requestParams dto.StartTimerRequest, userId int) *dto.StartTimerResponse
+	StopTimer(todoId int, userId int) *dto.StopTimerResponse
+	CreateTimeEntry(todoId int, requestParams dto.CreateTimeEntryRequest, userId int) *dto.CreateTimeEntryResponse
+	FetchTimeEntriesList(todoId int, userId int) *dto.TimeEntriesListResponse
+	FetchTimeReport(requestParams dto.TimeReportRequest, userId int) *dto.TimeReportResponse
+}
+
+type timeEntryService struct {
+	timeEntryRepository repositories.TimeEntryRepository
+	authorizer          todoAuthorizer
+}
+
+func NewTimeEntryService(todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, timeEntryRepository repositories.TimeEntryRepository) TimeEntryService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	return &timeEntryService{timeEntryRepository, authorizer}
+}
+
+func (tes *timeEntryService) StartTimer(todoId int, requestParams dto.StartTimerRequest, userId int) *dto.StartTimerResponse {
+	todo := models.Todo{}
+	if errorType, err := tes.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionTrack); err != nil {
+		return &dto.StartTimerResponse{TimeEntry: models.TimeEntry{}, Error: err, ErrorType: errorType}
+	}
+	running := models.TimeEntry{}
+	err := tes.timeEntryRepository.GetRunningTimeEntry(&running, userId)
+	if err == nil {
+		return &dto.StartTimerResponse{TimeEntry: running, Error: errTimerAlreadyRunning, ErrorType: "conflict"}
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dto.StartTimerResponse{TimeEntry: models.TimeEntry{}, Error: err, ErrorType: "internalServerError"}
+	}
+
+	entry := models.TimeEntry{}
+	entry.TodoID = todo.ID
+	entry.UserID = userId
+	entry.StartedAt = time.Now()
+	entry.Note = requestParams.Note
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(entry)
+	if validationErrors != nil {
+		return &dto.StartTimerResponse{TimeEntry: entry, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	createError := tes.timeEntryRepository.CreateTimeEntry(&entry)
+	if errors.Is(createError, repositories.ErrTimeEntryAlreadyRunning) {
+		return &dto.StartTimerResponse{TimeEntry: models.TimeEntry{}, Error: errTimerAlreadyRunning, ErrorType: "conflict"}
+	}
+	if createError != nil {
+		return &dto.StartTimerResponse{TimeEntry: entry, Error: createError, ErrorType: "internalServerError"}
+	}
+	return &dto.StartTimerResponse{TimeEntry: entry, Error: nil, ErrorType: ""}
+}
+
+func (tes *timeEntryService) StopTimer(todoId int, userId int) *dto.StopTimerResponse {
+	todo := models.Todo{}
+	if errorType, err := tes.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionTrack); err != nil {
+		return &dto.StopTimerResponse{TimeEntry: models.TimeEntry{}, Error: err, ErrorType: errorType}
+	}
+	entry := models.TimeEntry{}
+	if err := tes.timeEntryRepository.GetRunningTimeEntry(&entry, userId); err != nil || entry.TodoID != todo.ID {
+		return &dto.StopTimerResponse{TimeEntry: models.TimeEntry{}, Error: errTimerNotRunning, ErrorType: "notFound"}
+	}
+
+	err := tes.timeEntryRepository.StopTimeEntry(&entry, time.Now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dto.StopTimerResponse{TimeEntry: models.TimeEntry{}, Error: errTimerNotRunning, ErrorType: "notFound"}
+	}
+	if err != nil {
+		return &dto.StopTimerResponse{TimeEntry: entry, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.StopTimerResponse{TimeEntry: entry, Error: nil, ErrorType: ""}
+}
+
+func (tes *timeEntryService) CreateTimeEntry(todoId int, requestParams dto.CreateTimeEntryRequest, userId int) *dto.CreateTimeEntryResponse {
+	todo := models.Todo{}
+	if errorType, err := tes.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionTrack); err != nil {
+		return &dto.CreateTimeEntryResponse{TimeEntry: models.TimeEntry{}, Error: err, ErrorType: errorType}
+	}
+	if requestParams.StartedAt == nil || requestParams.EndedAt == nil {
+		return &dto.CreateTimeEntryResponse{TimeEntry: models.TimeEntry{}, Error: fmt.Errorf("started_at and ended_at are required"), ErrorType: "badRequest"}
+	}
+	if !requestParams.EndedAt.After(*requestParams.StartedAt) {
+		return &dto.CreateTimeEntryResponse{TimeEntry: models.TimeEntry{}, Error: fmt.Errorf("ended_at must be after started_at"), ErrorType: "badRequest"}
+	}
+	if requestParams.EndedAt.After(time.Now()) {
+		return &dto.CreateTimeEntryResponse{TimeEntry: models.TimeEntry{}, Error: fmt.Errorf("ended_at must not be in the future"), ErrorType: "badRequest"}
+	}
+
+	entry := models.TimeEntry{}
+	entry.TodoID = todo.ID
+	entry.UserID = userId
+	entry.StartedAt = *requestParams.StartedAt
+	entry.EndedAt = requestParams.EndedAt
+	entry.DurationSeconds = int64(requestParams.EndedAt.Sub(*requestParams.StartedAt).Seconds())
+	entry.Note = requestParams.Note
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(entry)
+	if validationErrors != nil {
+		return &dto.CreateTimeEntryResponse{TimeEntry: entry, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	if err := tes.timeEntryRepository.CreateTimeEntry(&entry); err != nil {
+		return &dto.CreateTimeEntryResponse{TimeEntry: entry, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.CreateTimeEntryResponse{TimeEntry: entry, Error: nil, ErrorType: ""}
+}
+
+func (tes *timeEntryService) FetchTimeEntriesList(todoId int, userId int) *dto.TimeEntriesListResponse {
+	todo := models.Todo{}
+	if errorType, err := tes.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.TimeEntriesListResponse{TimeEntries: []models.TimeEntry{}, Error: err, ErrorType: errorType}
+	}
+
+	entries := []models.TimeEntry{}
+	if err := tes.timeEntryRepository.GetTimeEntries(&entries, todo.ID); err != nil {
+		return &dto.TimeEntriesListResponse{TimeEntries: []models.TimeEntry{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TimeEntriesListResponse{TimeEntries: entries, Error: nil, ErrorType: ""}
+}
+
+// NOTE: 自分の記録のみを集計する(計測中のタイマーは含めない)
+func (tes *timeEntryService) FetchTimeReport(requestParams dto.TimeReportRequest, userId int) *dto.TimeReportResponse {
+	entries := []models.TimeEntry{}
+	if err := tes.timeEntryRepository.GetTimeEntriesInRange(&entries, userId, requestParams.From, requestParams.Until); err != nil {
+		return &dto.TimeReportResponse{Report: models.TimeReport{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TimeReportResponse{Report: models.NewTimeReport(entries, requestParams.From, requestParams.Until), Error: nil, ErrorType: ""}
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TestTimeEntryServiceSuite struct {
+	WithDbSuite
+}
+
+var testTimeEntryService TimeEntryService
+
+func (s *TestTimeEntryServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	timeEntryRepository := repositories.NewTimeEntryRepository(DbCon)
+	testTimeEntryService = NewTimeEntryService(todoRepository, todoShareRepository, timeEntryRepository)
+}
+
+func (s *TestTimeEntryServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTimeEntryServiceSuite) TestStartAndStopTimer() {
+	started := testTimeEntryService.StartTimer(todo.ID, dto.StartTimerRequest{Note: "test note"}, user.ID)
+	assert.Nil(s.T(), started.Error)
+	assert.Nil(s.T(), started.TimeEntry.EndedAt)
+
+	stopped := testTimeEntryService.StopTimer(todo.ID, user.ID)
+	assert.Nil(s.T(), stopped.Error)
+	assert.Equal(s.T(), started.TimeEntry.ID, stopped.TimeEntry.ID)
+	assert.NotNil(s.T(), stopped.TimeEntry.EndedAt)
+
+	// NOTE: 計測中のタイマーが無い場合は停止できないこと
+	assert.Equal(s.T(), "notFound", testTimeEntryService.StopTimer(todo.ID, user.ID).ErrorType)
+}
+
+func (s *TestTimeEntryServiceSuite) TestStartTimer_AlreadyRunning() {
+	otherTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID}
+	if err := DbCon.Create(&otherTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	started := testTimeEntryService.StartTimer(todo.ID, dto.StartTimerRequest{}, user.ID)
+
+	// NOTE: 別のTodoでも同時に計測できないこと
+	result := testTimeEntryService.StartTimer(otherTodo.ID, dto.StartTimerRequest{}, user.ID)
+
+	assert.Equal(s.T(), errTimerAlreadyRunning, result.Error)
+	assert.Equal(s.T(), "conflict", result.ErrorType)
+	assert.Equal(s.T(), started.TimeEntry.ID, result.TimeEntry.ID)
+}
+
+// NOTE: 同時に開始された状態を再現するため、計測中のタイマーの確認を常に未計測として返す
+type concurrentlyStartedTimeEntryRepository struct {
+	repositories.TimeEntryRepository
+}
+
+func (r concurrentlyStartedTimeEntryRepository) GetRunningTimeEntry(entry *models.TimeEntry, userId int) error {
+	return gorm.ErrRecordNotFound
+}
+
+func (s *TestTimeEntryServiceSuite) TestStartTimer_ConcurrentStart() {
+	timeEntryService := NewTimeEntryService(repositories.NewTodoRepository(DbCon), repositories.NewTodoShareRepository(DbCon), concurrentlyStartedTimeEntryRepository{repositories.NewTimeEntryRepository(DbCon)})
+	started := timeEntryService.StartTimer(todo.ID, dto.StartTimerRequest{}, user.ID)
+
+	// NOTE: 確認をすり抜けても、計測中のタイマーは1つのみ登録されること
+	result := timeEntryService.StartTimer(todo.ID, dto.StartTimerRequest{}, user.ID)
+
+	assert.Nil(s.T(), started.Error)
+	assert.Equal(s.T(), errTimerAlreadyRunning, result.Error)
+	assert.Equal(s.T(), "conflict", result.ErrorType)
+	var runningCount int64
+	DbCon.Model(&models.TimeEntry{}).Where("user_id = ? AND ended_at IS NULL", user.ID).Count(&runningCount)
+	assert.Equal(s.T(), int64(1), runningCount)
+}
+
+func (s *TestTimeEntryServiceSuite) TestStartTimer_Permissions() {
+	viewer := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "viewer@example.com"}).(*models.User)
+	if err := DbCon.Create(&viewer).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: viewer.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	// NOTE: 閲覧者は記録を参照できるが計測はできないこと
+	assert.Nil(s.T(), testTimeEntryService.FetchTimeEntriesList(todo.ID, viewer.ID).Error)
+	assert.Equal(s.T(), "forbidden", testTimeEntryService.StartTimer(todo.ID, dto.StartTimerRequest{}, viewer.ID).ErrorType)
+}
+
+func (s *TestTimeEntryServiceSuite) TestCreateTimeEntry() {
+	startedAt := time.Now().Add(-2 * time.Hour)
+	endedAt := startedAt.Add(45 * time.Minute)
+	result := testTimeEntryService.CreateTimeEntry(todo.ID, dto.CreateTimeEntryRequest{StartedAt: &startedAt, EndedAt: &endedAt}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), int64(2700), result.TimeEntry.DurationSeconds)
+	assert.Len(s.T(), testTimeEntryService.FetchTimeEntriesList(todo.ID, user.ID).TimeEntries, 1)
+}
+
+func (s *TestTimeEntryServiceSuite) TestCreateTimeEntry_InvalidRange() {
+	startedAt := time.Now().Add(-time.Hour)
+	endedAt := startedAt.Add(-time.Minute)
+	result := testTimeEntryService.CreateTimeEntry(todo.ID, dto.CreateTimeEntryRequest{StartedAt: &startedAt, EndedAt: &endedAt}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestTimeEntryServiceSuite) TestFetchTimeReport() {
+	otherTodo := models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID}
+	if err := DbCon.Create(&otherTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	from := time.Date(2026, 1, 10, 0, 0, 0, 0, time.Local)
+	until := from.AddDate(0, 0, 2)
+	// NOTE: 日を跨ぐ記録(1/10 23:00〜1/11 01:00)と期間外にはみ出す記録(1/11 23:30〜1/12 00:30)
+	entries := []struct {
+		todoId    int
+		startedAt time.Time
+		endedAt   time.Time
+	}{
+		{todo.ID, from.Add(23 * time.Hour), from.Add(25 * time.Hour)},
+		{otherTodo.ID, from.Add(47*time.Hour + 30*time.Minute), from.Add(48*time.Hour + 30*time.Minute)},
+	}
+	tags := []models.TodoTag{{TodoID: todo.ID, Name: "work"}, {TodoID: todo.ID, Name: "client-a"}, {TodoID: otherTodo.ID, Name: "work"}}
+	if err := DbCon.Create(&tags).Error; err != nil {
+		s.T().Fatalf("failed to create test tags %v", err)
+	}
+	for _, e := range entries {
+		endedAt := e.endedAt
+		if err := DbCon.Create(&models.TimeEntry{TodoID: e.todoId, UserID: user.ID, StartedAt: e.startedAt, EndedAt: &endedAt}).Error; err != nil {
+			s.T().Fatalf("failed to create test time entry %v", err)
+		}
+	}
+
+	result := testTimeEntryService.FetchTimeReport(dto.TimeReportRequest{From: from, Until: until}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "2026-01-10", result.Report.From)
+	assert.Equal(s.T(), "2026-01-11", result.Report.To)
+	assert.Equal(s.T(), int64(9000), result.Report.TotalSeconds)
+	assert.Equal(s.T(), []models.TodoTimeReport{
+		{TodoID: todo.ID, Title: "test title 1", Seconds: 7200},
+		{TodoID: otherTodo.ID, Title: "test title 2", Seconds: 1800},
+	}, result.Report.Todos)
+	// NOTE: タグごとの集計では複数のタグが付いたTodoの時間をそれぞれに計上すること
+	assert.Equal(s.T(), []models.TagTimeReport{
+		{Tag: "work", Seconds: 9000},
+		{Tag: "client-a", Seconds: 7200},
+	}, result.Report.Tags)
+	assert.Equal(s.T(), []models.DayTimeReport{
+		{Date: "2026-01-10", Seconds: 3600},
+		{Date: "2026-01-11", Seconds: 5400},
+	}, result.Report.Days)
+}
+
+func TestTimeEntryService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTimeEntryServiceSuite))
+}
@@ -0,0 +1,84 @@
+package services
+
+import (
+	"app/models"
+	"app/repositories"
+	"errors"
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+const (
+	todoPermissionRead    = "read"
+	todoPermissionComment = "comment"
+	todoPermissionStatus  = "status"
+	todoPermissionTrack   = "track"
+	todoPermissionEdit    = "edit"
+	todoPermissionManage  = "manage"
+
+	// NOTE: 担当者は共有の権限とは別に、参照と完了・再開・作業時間の記録のみ可能とする
+	todoRoleAssignee = "assignee"
+)
+
+// NOTE: 役割ごとに許可する操作
+var todoRolePermissions = map[string][]string{
+	models.TodoShareRoleOwner:  {todoPermissionRead, todoPermissionComment, todoPermissionStatus, todoPermissionTrack, todoPermissionEdit, todoPermissionManage},
+	models.TodoShareRoleEditor: {todoPermissionRead, todoPermissionComment, todoPermissionStatus, todoPermissionTrack, todoPermissionEdit},
+	todoRoleAssignee:           {todoPermissionRead, todoPermissionComment, todoPermissionStatus, todoPermissionTrack},
+	models.TodoShareRoleViewer: {todoPermissionRead},
+}
+
+// NOTE: 参照権限が無い場合は存在を明かさないため見つからないものとして扱う
+var errTodoNotFound = errors.New("todo not found")
+
+// NOTE: 権限が足りない操作を行った場合のエラー
+var errTodoPermissionDenied = errors.New("you do not have permission to perform this operation on the todo")
+
+// NOTE: Todoに対する操作の可否を判定する(複数のサービスから利用する)
+type todoAuthorizer struct {
+	todoRepository      repositories.TodoRepository
+	todoShareRepository repositories.TodoShareRepository
+}
+
+// NOTE: 作成者・担当者・共有の役割をもとに操作の可否を判定する
+func (ta todoAuthorizer) authorizeTodo(todo *models.Todo, id int, userId int, permission string) (string, error) {
+	if err := ta.todoRepository.FindTodoById(todo, id); err != nil {
+		return "notFound", err
+	}
+	roles, err := ta.todoRoles(*todo, userId)
+	if err != nil {
+		return "internalServerError", err
+	}
+	if len(roles) == 0 {
+		return "notFound", errTodoNotFound
+	}
+
+	for _, role := range roles {
+		if slices.Contains(todoRolePermissions[role], permission) {
+			return "", nil
+		}
+	}
+	return "forbidden", errTodoPermissionDenied
+}
+
+// NOTE: ユーザがTodoに対して持つ役割の一覧
+func (ta todoAuthorizer) todoRoles(todo models.Todo, userId int) ([]string, error) {
+	if todo.UserID == userId {
+		return []string{models.TodoShareRoleOwner}, nil
+	}
+
+	roles := []string{}
+	if todo.AssigneeID != nil && *todo.AssigneeID == userId {
+		roles = append(roles, todoRoleAssignee)
+	}
+	share := models.TodoShare{}
+	err := ta.todoShareRepository.GetTodoShare(&share, todo.ID, userId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		roles = append(roles, share.Role)
+	}
+	return roles, nil
+}
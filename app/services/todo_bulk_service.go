@@ -0,0 +1,141 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/utils"
+	"errors"
+	"fmt"
+)
+
+const maxBulkTodoOperations = 100
+
+// NOTE: 操作の失敗をトランザクションのロールバックに伝えるためのエラー
+var errBulkTodoOperationFailed = errors.New("bulk todo operation failed")
+
+type TodoBulkService interface {
+	BulkTodos(requestParams dto.BulkTodoRequest, userId int) *dto.BulkTodoResponse
+}
+
+type todoBulkService struct {
+	transactionRepository repositories.TransactionRepository
+}
+
+func NewTodoBulkService(transactionRepository repositories.TransactionRepository) TodoBulkService {
+	return &todoBulkService{transactionRepository}
+}
+
+func (tbs *todoBulkService) BulkTodos(requestParams dto.BulkTodoRequest, userId int) *dto.BulkTodoResponse {
+	mode := requestParams.Mode
+	if mode == "" {
+		mode = dto.BulkTodoModeAtomic
+	}
+	if mode != dto.BulkTodoModeAtomic && mode != dto.BulkTodoModePartial {
+		return &dto.BulkTodoResponse{Error: fmt.Errorf("mode must be one of %s, %s", dto.BulkTodoModeAtomic, dto.BulkTodoModePartial), ErrorType: "badRequest"}
+	}
+	if len(requestParams.Operations) == 0 || len(requestParams.Operations) > maxBulkTodoOperations {
+		return &dto.BulkTodoResponse{Error: fmt.Errorf("operations must contain between 1 and %d items", maxBulkTodoOperations), ErrorType: "badRequest"}
+	}
+
+	// NOTE: 実行されなかった操作はskippedとして返す
+	results := make([]dto.BulkTodoResult, len(requestParams.Operations))
+	for i, operation := range requestParams.Operations {
+		results[i] = dto.BulkTodoResult{Index: i, Op: operation.Op, Status: dto.BulkTodoStatusSkipped}
+	}
+
+	failedIndex := -1
+	err := tbs.transactionRepository.Transaction(func(tx repositories.TransactionRepository) error {
+		for i, operation := range requestParams.Operations {
+			if mode == dto.BulkTodoModeAtomic {
+				results[i] = tbs.executeOperation(tx, i, operation, userId)
+				if results[i].Status == dto.BulkTodoStatusFailed {
+					failedIndex = i
+					return errBulkTodoOperationFailed
+				}
+				continue
+			}
+
+			// NOTE: partialの場合は操作ごとにセーブポイントを作り、失敗した操作のみ取り消す
+			err := tx.Transaction(func(savepoint repositories.TransactionRepository) error {
+				results[i] = tbs.executeOperation(savepoint, i, operation, userId)
+				if results[i].Status == dto.BulkTodoStatusFailed {
+					return errBulkTodoOperationFailed
+				}
+				return nil
+			})
+			if err != nil && !errors.Is(err, errBulkTodoOperationFailed) {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if failedIndex >= 0 {
+		for i := 0; i < failedIndex; i++ {
+			results[i].Status = dto.BulkTodoStatusRolledBack
+			results[i].Todo = nil
+		}
+		errorType := "badRequest"
+		if results[failedIndex].ErrorType == "internalServerError" {
+			errorType = "internalServerError"
+		}
+		return &dto.BulkTodoResponse{Results: results, Error: fmt.Errorf("operation %d failed, all operations were rolled back", failedIndex), ErrorType: errorType}
+	}
+	if err != nil {
+		return &dto.BulkTodoResponse{Results: results, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.BulkTodoResponse{Results: results, Error: nil, ErrorType: ""}
+}
+
+// NOTE: トランザクションに紐づいたTodoServiceで1件分の操作を実行する
+func (tbs *todoBulkService) executeOperation(tx repositories.TransactionRepository, index int, operation dto.BulkTodoOperation, userId int) dto.BulkTodoResult {
+	todoService := NewTodoService(tx.TodoRepository(), tx.TodoRevisionRepository(), tx.UserRepository(), tx.TodoShareRepository(), tx.NotificationRepository(), tx.UserSettingRepository(), tx)
+	result := dto.BulkTodoResult{Index: index, Op: operation.Op}
+
+	var todo *models.Todo
+	var err error
+	var errorType string
+	switch operation.Op {
+	case dto.BulkTodoOpCreate:
+		response := todoService.CreateTodo(dto.CreateTodoRequest{
+			Title:          operation.Title,
+			Content:        operation.Content,
+			Priority:       operation.Priority,
+			DueAt:          operation.DueAt,
+			RecurrenceRule: operation.RecurrenceRule,
+		}, userId)
+		todo, err, errorType = &response.Todo, response.Error, response.ErrorType
+	case dto.BulkTodoOpUpdate:
+		response := todoService.UpdateTodo(operation.ID, dto.UpdateTodoRequest{
+			Title:          operation.Title,
+			Content:        operation.Content,
+			Priority:       operation.Priority,
+			DueAt:          operation.DueAt,
+			RecurrenceRule: operation.RecurrenceRule,
+			IfMatch:        operation.IfMatch,
+		}, userId)
+		todo, err, errorType = &response.Todo, response.Error, response.ErrorType
+	case dto.BulkTodoOpDelete:
+		response := todoService.DeleteTodo(operation.ID, dto.DeleteTodoRequest{IfMatch: operation.IfMatch}, userId)
+		err, errorType = response.Error, response.ErrorType
+	case dto.BulkTodoOpComplete:
+		response := todoService.CompleteTodo(operation.ID, dto.CompleteTodoRequest{CompleteChecklistItems: operation.CompleteChecklistItems}, userId)
+		todo, err, errorType = &response.Todo, response.Error, response.ErrorType
+	default:
+		err, errorType = fmt.Errorf("op must be one of %s, %s, %s, %s", dto.BulkTodoOpCreate, dto.BulkTodoOpUpdate, dto.BulkTodoOpDelete, dto.BulkTodoOpComplete), "badRequest"
+	}
+
+	if err != nil {
+		result.Status = dto.BulkTodoStatusFailed
+		result.ErrorType = errorType
+		result.Error = err.Error()
+		if errorType == "validationError" {
+			result.Error = utils.CoordinateValidationErrors(err)
+		}
+		return result
+	}
+	result.Status = dto.BulkTodoStatusSucceeded
+	result.Todo = todo
+	return result
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoBulkServiceSuite struct {
+	WithDbSuite
+}
+
+var testTodoBulkService TodoBulkService
+
+func (s *TestTodoBulkServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	transactionRepository := repositories.NewTransactionRepository(DbCon)
+	testTodoBulkService = NewTodoBulkService(transactionRepository)
+}
+
+func (s *TestTodoBulkServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoBulkServiceSuite) TestBulkTodos() {
+	requestParams := dto.BulkTodoRequest{Operations: []dto.BulkTodoOperation{
+		{Op: dto.BulkTodoOpCreate, Title: "test title 2", Content: "test content 2"},
+		{Op: dto.BulkTodoOpUpdate, ID: todo.ID, Title: "test updated title 1", Content: "test updated content 1"},
+		{Op: dto.BulkTodoOpComplete, ID: todo.ID},
+	}}
+	result := testTodoBulkService.BulkTodos(requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Results, 3)
+	for _, operationResult := range result.Results {
+		assert.Equal(s.T(), dto.BulkTodoStatusSucceeded, operationResult.Status)
+	}
+	assert.Equal(s.T(), "test title 2", result.Results[0].Todo.Title)
+	assert.Equal(s.T(), models.TodoStatusDone, result.Results[2].Todo.Status)
+
+	todos := []models.Todo{}
+	DbCon.Where("user_id = ?", user.ID).Find(&todos)
+	assert.Len(s.T(), todos, 2)
+}
+
+func (s *TestTodoBulkServiceSuite) TestBulkTodos_AtomicRollback() {
+	requestParams := dto.BulkTodoRequest{Mode: dto.BulkTodoModeAtomic, Operations: []dto.BulkTodoOperation{
+		{Op: dto.BulkTodoOpDelete, ID: todo.ID},
+		{Op: dto.BulkTodoOpCreate, Title: "", Content: "test content 2"},
+		{Op: dto.BulkTodoOpCreate, Title: "test title 3", Content: "test content 3"},
+	}}
+	result := testTodoBulkService.BulkTodos(requestParams, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+	assert.Equal(s.T(), dto.BulkTodoStatusRolledBack, result.Results[0].Status)
+	assert.Equal(s.T(), dto.BulkTodoStatusFailed, result.Results[1].Status)
+	assert.Equal(s.T(), "validationError", result.Results[1].ErrorType)
+	// NOTE: CoordinateValidationErrorsと同じ形式でエラーが返ること
+	assert.Equal(s.T(), map[string][]string{"Title": {"Titleは必須です"}}, result.Results[1].Error)
+	assert.Equal(s.T(), dto.BulkTodoStatusSkipped, result.Results[2].Status)
+
+	// NOTE: 削除が取り消されていることを確認
+	todos := []models.Todo{}
+	DbCon.Where("user_id = ?", user.ID).Find(&todos)
+	assert.Len(s.T(), todos, 1)
+}
+
+func (s *TestTodoBulkServiceSuite) TestBulkTodos_Partial() {
+	requestParams := dto.BulkTodoRequest{Mode: dto.BulkTodoModePartial, Operations: []dto.BulkTodoOperation{
+		{Op: dto.BulkTodoOpDelete, ID: todo.ID},
+		{Op: dto.BulkTodoOpUpdate, ID: todo.ID + 100, Title: "test updated title"},
+		{Op: dto.BulkTodoOpCreate, Title: "test title 3", Content: "test content 3"},
+	}}
+	result := testTodoBulkService.BulkTodos(requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), dto.BulkTodoStatusSucceeded, result.Results[0].Status)
+	assert.Equal(s.T(), dto.BulkTodoStatusFailed, result.Results[1].Status)
+	assert.Equal(s.T(), "notFound", result.Results[1].ErrorType)
+	assert.Equal(s.T(), dto.BulkTodoStatusSucceeded, result.Results[2].Status)
+
+	// NOTE: 成功した操作のみ反映されていることを確認
+	todos := []models.Todo{}
+	DbCon.Where("user_id = ?", user.ID).Find(&todos)
+	assert.Len(s.T(), todos, 1)
+	assert.Equal(s.T(), "test title 3", todos[0].Title)
+}
+
+func (s *TestTodoBulkServiceSuite) TestBulkTodos_InvalidRequest() {
+	invalidMode := testTodoBulkService.BulkTodos(dto.BulkTodoRequest{Mode: "unknown", Operations: []dto.BulkTodoOperation{{Op: dto.BulkTodoOpDelete, ID: todo.ID}}}, user.ID)
+	emptyOperations := testTodoBulkService.BulkTodos(dto.BulkTodoRequest{}, user.ID)
+	unknownOp := testTodoBulkService.BulkTodos(dto.BulkTodoRequest{Operations: []dto.BulkTodoOperation{{Op: "archive", ID: todo.ID}}}, user.ID)
+
+	assert.Equal(s.T(), "badRequest", invalidMode.ErrorType)
+	assert.Equal(s.T(), "badRequest", emptyOperations.ErrorType)
+	assert.Equal(s.T(), "badRequest", unknownOp.ErrorType)
+	assert.Equal(s.T(), "badRequest", unknownOp.Results[0].ErrorType)
+}
+
+func TestTodoBulkService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoBulkServiceSuite))
+}
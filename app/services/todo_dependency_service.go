@@ -0,0 +1,102 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"errors"
+	"fmt"
+)
+
+// NOTE: ブロッカーに指定できないTodoの場合は存在を明かさない
+var errBlockerTodoNotFound = errors.New("blocker todo not found")
+
+type TodoDependencyService interface {
+	AddTodoDependency(id int, requestParams dto.AddTodoDependencyRequest, userId int) *dto.AddTodoDependencyResponse
+	FetchBlockersList(id int, userId int) *dto.BlockersListResponse
+	RemoveTodoDependency(id int, blockerId int, userId int) *dto.RemoveTodoDependencyResponse
+}
+
+type todoDependencyService struct {
+	todoRepository           repositories.TodoRepository
+	todoDependencyRepository repositories.TodoDependencyRepository
+	authorizer               todoAuthorizer
+}
+
+func NewTodoDependencyService(todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, todoDependencyRepository repositories.TodoDependencyRepository) TodoDependencyService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	return &todoDependencyService{todoRepository, todoDependencyRepository, authorizer}
+}
+
+func (tds *todoDependencyService) AddTodoDependency(id int, requestParams dto.AddTodoDependencyRequest, userId int) *dto.AddTodoDependencyResponse {
+	todo := models.Todo{}
+	if errorType, err := tds.authorizer.authorizeTodo(&todo, id, userId, todoPermissionEdit); err != nil {
+		return &dto.AddTodoDependencyResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	if requestParams.BlockerID == todo.ID {
+		return &dto.AddTodoDependencyResponse{Todo: todo, Error: fmt.Errorf("todo cannot be blocked by itself"), ErrorType: "badRequest"}
+	}
+
+	// NOTE: ブロッカーは参照可能な同じユーザのTodoのみ指定できる
+	blocker := models.Todo{}
+	errorType, err := tds.authorizer.authorizeTodo(&blocker, requestParams.BlockerID, userId, todoPermissionRead)
+	if errorType == "internalServerError" {
+		return &dto.AddTodoDependencyResponse{Todo: todo, Error: err, ErrorType: errorType}
+	}
+	if err != nil || blocker.UserID != todo.UserID {
+		return &dto.AddTodoDependencyResponse{Todo: todo, Error: errBlockerTodoNotFound, ErrorType: "badRequest"}
+	}
+
+	// NOTE: 既に登録済みの場合はそのまま返す
+	dependency := models.TodoDependency{}
+	if err := tds.todoDependencyRepository.GetTodoDependency(&dependency, todo.ID, blocker.ID); err == nil {
+		return &dto.AddTodoDependencyResponse{Todo: todo, Error: nil, ErrorType: ""}
+	}
+	// NOTE: ブロッカーが既にこのTodoの完了を待っている場合は循環するため登録しない
+	dependency = models.TodoDependency{TodoID: todo.ID, BlockerID: blocker.ID}
+	createError := tds.todoDependencyRepository.CreateTodoDependency(&dependency)
+	if errors.Is(createError, repositories.ErrTodoDependencyCycle) {
+		return &dto.AddTodoDependencyResponse{Todo: todo, Error: createError, ErrorType: "badRequest"}
+	}
+	if createError != nil {
+		return &dto.AddTodoDependencyResponse{Todo: todo, Error: createError, ErrorType: "internalServerError"}
+	}
+	// NOTE: blockedを反映するため取得し直す
+	if err := tds.todoRepository.FindTodoById(&todo, todo.ID); err != nil {
+		return &dto.AddTodoDependencyResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.AddTodoDependencyResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+func (tds *todoDependencyService) FetchBlockersList(id int, userId int) *dto.BlockersListResponse {
+	todo := models.Todo{}
+	if errorType, err := tds.authorizer.authorizeTodo(&todo, id, userId, todoPermissionRead); err != nil {
+		return &dto.BlockersListResponse{Blockers: []models.Todo{}, Error: err, ErrorType: errorType}
+	}
+
+	blockers := []models.Todo{}
+	if err := tds.todoDependencyRepository.GetBlockers(&blockers, todo.ID); err != nil {
+		return &dto.BlockersListResponse{Blockers: []models.Todo{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.BlockersListResponse{Blockers: blockers, Error: nil, ErrorType: ""}
+}
+
+func (tds *todoDependencyService) RemoveTodoDependency(id int, blockerId int, userId int) *dto.RemoveTodoDependencyResponse {
+	todo := models.Todo{}
+	if errorType, err := tds.authorizer.authorizeTodo(&todo, id, userId, todoPermissionEdit); err != nil {
+		return &dto.RemoveTodoDependencyResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	dependency := models.TodoDependency{}
+	if err := tds.todoDependencyRepository.GetTodoDependency(&dependency, todo.ID, blockerId); err != nil {
+		return &dto.RemoveTodoDependencyResponse{Todo: todo, Error: err, ErrorType: "notFound"}
+	}
+
+	if err := tds.todoDependencyRepository.DeleteTodoDependency(&dependency); err != nil {
+		return &dto.RemoveTodoDependencyResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	// NOTE: blockedを反映するため取得し直す
+	if err := tds.todoRepository.FindTodoById(&todo, todo.ID); err != nil {
+		return &dto.RemoveTodoDependencyResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.RemoveTodoDependencyResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoDependencyServiceSuite struct {
+	WithDbSuite
+}
+
+var testTodoDependencyService TodoDependencyService
+var blockerTodo models.Todo
+
+func (s *TestTodoDependencyServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	blockerTodo = models.Todo{Title: "test title 2", Content: "test content 2", UserID: user.ID}
+	if err := DbCon.Create(&blockerTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	todoDependencyRepository := repositories.NewTodoDependencyRepository(DbCon)
+	testTodoDependencyService = NewTodoDependencyService(todoRepository, todoShareRepository, todoDependencyRepository)
+}
+
+func (s *TestTodoDependencyServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoDependencyServiceSuite) TestAddTodoDependency() {
+	result := testTodoDependencyService.AddTodoDependency(todo.ID, dto.AddTodoDependencyRequest{BlockerID: blockerTodo.ID}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.True(s.T(), result.Todo.Blocked)
+	blockers := testTodoDependencyService.FetchBlockersList(todo.ID, user.ID)
+	assert.Len(s.T(), blockers.Blockers, 1)
+}
+
+func (s *TestTodoDependencyServiceSuite) TestAddTodoDependency_Self() {
+	result := testTodoDependencyService.AddTodoDependency(todo.ID, dto.AddTodoDependencyRequest{BlockerID: todo.ID}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestTodoDependencyServiceSuite) TestAddTodoDependency_OtherUsersTodo() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	otherTodo := models.Todo{Title: "test title 3", Content: "test content 3", UserID: otherUser.ID}
+	if err := DbCon.Create(&otherTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	result := testTodoDependencyService.AddTodoDependency(todo.ID, dto.AddTodoDependencyRequest{BlockerID: otherTodo.ID}, user.ID)
+
+	assert.Equal(s.T(), errBlockerTodoNotFound, result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestTodoDependencyServiceSuite) TestAddTodoDependency_Cycle() {
+	thirdTodo := models.Todo{Title: "test title 3", Content: "test content 3", UserID: user.ID}
+	if err := DbCon.Create(&thirdTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+	assert.Nil(s.T(), testTodoDependencyService.AddTodoDependency(todo.ID, dto.AddTodoDependencyRequest{BlockerID: blockerTodo.ID}, user.ID).Error)
+	assert.Nil(s.T(), testTodoDependencyService.AddTodoDependency(blockerTodo.ID, dto.AddTodoDependencyRequest{BlockerID: thirdTodo.ID}, user.ID).Error)
+
+	// NOTE: todo <- blockerTodo <- thirdTodo <- todo となるため登録できないこと
+	result := testTodoDependencyService.AddTodoDependency(thirdTodo.ID, dto.AddTodoDependencyRequest{BlockerID: todo.ID}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestTodoDependencyServiceSuite) TestCompleteTodo_Blocked() {
+	testTodoDependencyService.AddTodoDependency(todo.ID, dto.AddTodoDependencyRequest{BlockerID: blockerTodo.ID}, user.ID)
+	todoService := NewTodoService(repositories.NewTodoRepository(DbCon), repositories.NewTodoRevisionRepository(DbCon), repositories.NewUserRepository(DbCon), repositories.NewTodoShareRepository(DbCon), repositories.NewNotificationRepository(DbCon), repositories.NewUserSettingRepository(DbCon), repositories.NewTransactionRepository(DbCon))
+
+	result := todoService.CompleteTodo(todo.ID, dto.CompleteTodoRequest{}, user.ID)
+	assert.Equal(s.T(), errTodoBlocked, result.Error)
+	assert.Equal(s.T(), "conflict", result.ErrorType)
+
+	// NOTE: ブロッカーが完了すれば完了できること
+	assert.Nil(s.T(), todoService.CompleteTodo(blockerTodo.ID, dto.CompleteTodoRequest{}, user.ID).Error)
+	result = todoService.CompleteTodo(todo.ID, dto.CompleteTodoRequest{}, user.ID)
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), models.TodoStatusDone, result.Todo.Status)
+}
+
+func (s *TestTodoDependencyServiceSuite) TestRemoveTodoDependency() {
+	testTodoDependencyService.AddTodoDependency(todo.ID, dto.AddTodoDependencyRequest{BlockerID: blockerTodo.ID}, user.ID)
+
+	result := testTodoDependencyService.RemoveTodoDependency(todo.ID, blockerTodo.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.False(s.T(), result.Todo.Blocked)
+	assert.Equal(s.T(), "notFound", testTodoDependencyService.RemoveTodoDependency(todo.ID, blockerTodo.ID, user.ID).ErrorType)
+}
+
+func TestTodoDependencyService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoDependencyServiceSuite))
+}
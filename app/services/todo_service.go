@@ -4,100 +4,797 @@ import (
 	"app/dto"
 	"app/models"
 	"app/repositories"
+	"app/utils"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/go-playground/validator/v10"
 )
 
+// NOTE: 未完了のブロッカーがあるTodoを完了しようとした場合のエラー
+var errTodoBlocked = errors.New("todo is blocked by incomplete todos")
+
+// NOTE: 移動先の前後に別の作成者の一覧にあるTodoを指定した場合のエラー
+var errTodoPositionAnotherList = errors.New("before_id and after_id must be todos in the same list as the moved todo")
+
 type TodoService interface {
 	CreateTodo(requestParams dto.CreateTodoRequest, userId int) *dto.CreateTodoResponse
-	FetchTodosList(userId int) *dto.TodosListResponse
+	QuickAddTodo(requestParams dto.QuickAddTodoRequest, userId int) *dto.QuickAddTodoResponse
+	FetchTodosList(filter models.TodoFilter, userId int) *dto.TodosListResponse
 	FetchTodo(id int, userId int) *dto.FetchTodoResponse
+	FetchAssignedTodosList(userId int) *dto.AssignedTodosListResponse
+	AssignTodo(id int, requestParams dto.AssignTodoRequest, userId int) *dto.AssignTodoResponse
+	FetchSharedTodosList(userId int) *dto.SharedTodosListResponse
+	ShareTodo(id int, requestParams dto.ShareTodoRequest, userId int) *dto.ShareTodoResponse
+	FetchTodoShares(id int, userId int) *dto.TodoSharesResponse
+	RevokeTodoShare(id int, shareUserId int, userId int) *dto.RevokeTodoShareResponse
 	UpdateTodo(id int, requestParams dto.UpdateTodoRequest, userId int) *dto.UpdateTodoResponse
-	DeleteTodo(id int, userId int) *dto.DeleteTodoResponse
+	PatchTodo(id int, requestParams dto.PatchTodoRequest, userId int) *dto.PatchTodoResponse
+	DeleteTodo(id int, requestParams dto.DeleteTodoRequest, userId int) *dto.DeleteTodoResponse
+	MoveTodo(id int, requestParams dto.MoveTodoRequest, userId int) *dto.MoveTodoResponse
+	CompleteTodo(id int, requestParams dto.CompleteTodoRequest, userId int) *dto.CompleteTodoResponse
+	ReopenTodo(id int, userId int) *dto.ReopenTodoResponse
+	ArchiveTodo(id int, userId int) *dto.ArchiveTodoResponse
+	UnarchiveTodo(id int, userId int) *dto.UnarchiveTodoResponse
+	FetchTodoOccurrences(id int, count int, userId int) *dto.TodoOccurrencesResponse
+	FetchTrashedTodosList(userId int) *dto.TrashedTodosListResponse
+	RestoreTodo(id int, userId int) *dto.RestoreTodoResponse
+	PurgeTodo(id int, userId int) *dto.PurgeTodoResponse
+	PurgeTrash(userId int) *dto.PurgeTrashResponse
+	PurgeExpiredTrash(retention time.Duration) *dto.PurgeTrashResponse
+	FetchTodoHistory(id int, userId int) *dto.TodoHistoryResponse
+	RevertTodo(id int, revisionNumber int, userId int) *dto.RevertTodoResponse
 }
 
 type todoService struct {
-	todoRepository repositories.TodoRepository
+	todoRepository         repositories.TodoRepository
+	todoRevisionRepository repositories.TodoRevisionRepository
+	userRepository         repositories.UserRepository
+	todoShareRepository    repositories.TodoShareRepository
+	transactionRepository  repositories.TransactionRepository
+	authorizer             todoAuthorizer
+	publisher              notificationPublisher
+}
+
+func NewTodoService(todoRepository repositories.TodoRepository, todoRevisionRepository repositories.TodoRevisionRepository, userRepository repositories.UserRepository, todoShareRepository repositories.TodoShareRepository, notificationRepository repositories.NotificationRepository, userSettingRepository repositories.UserSettingRepository, transactionRepository repositories.TransactionRepository) TodoService {
+	return newTodoService(todoRepository, todoRevisionRepository, userRepository, todoShareRepository, notificationRepository, userSettingRepository, transactionRepository)
 }
 
-func NewTodoService(todoRepository repositories.TodoRepository) TodoService {
-	return &todoService{todoRepository}
+func newTodoService(todoRepository repositories.TodoRepository, todoRevisionRepository repositories.TodoRevisionRepository, userRepository repositories.UserRepository, todoShareRepository repositories.TodoShareRepository, notificationRepository repositories.NotificationRepository, userSettingRepository repositories.UserSettingRepository, transactionRepository repositories.TransactionRepository) *todoService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	publisher := notificationPublisher{notificationRepository, userSettingRepository}
+	return &todoService{todoRepository, todoRevisionRepository, userRepository, todoShareRepository, transactionRepository, authorizer, publisher}
 }
 
 func (ts *todoService) CreateTodo(requestParams dto.CreateTodoRequest, userId int) *dto.CreateTodoResponse {
 	todo := models.Todo{}
 	todo.Title = requestParams.Title
 	todo.Content = requestParams.Content
+	todo.Status = models.TodoStatusTodo
+	todo.Priority = requestParams.Priority
+	todo.DueAt = requestParams.DueAt
+	todo.RecurrenceRule = requestParams.RecurrenceRule
 	todo.UserID = userId
+	// NOTE: チェックリスト・タグはTodoと同時に作成する
+	for i, title := range requestParams.ChecklistItems {
+		todo.ChecklistItems = append(todo.ChecklistItems, models.ChecklistItem{Title: title, Position: i + 1})
+	}
+	todo.ChecklistProgress = models.NewChecklistProgress(todo.ChecklistItems)
+	tags, err := normalizeTagNames(requestParams.Tags)
+	if err != nil {
+		return &dto.CreateTodoResponse{Todo: todo, Error: err, ErrorType: "badRequest"}
+	}
+	for _, name := range tags {
+		todo.TodoTags = append(todo.TodoTags, models.TodoTag{Name: name})
+	}
+	todo.Tags = tags
 	// NOTE: バリデーションチェック
 	validate := validator.New()
 	validationErrors := validate.Struct(todo)
 	if validationErrors != nil {
 		return &dto.CreateTodoResponse{Todo: todo, Error: validationErrors, ErrorType: "validationError"}
 	}
+	if err := ts.setRecurrenceStartAt(&todo, ""); err != nil {
+		return &dto.CreateTodoResponse{Todo: todo, Error: err, ErrorType: "badRequest"}
+	}
 
 	// NOTE: Create処理
-	err := ts.todoRepository.CreateTodo(&todo)
-	if err != nil {
+	if err := ts.todoRepository.CreateTodo(&todo); err != nil {
+		return &dto.CreateTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	if err := ts.recordRevision(models.TodoSnapshot{}, todo, models.TodoRevisionActionCreate, userId); err != nil {
 		return &dto.CreateTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
 	}
 	return &dto.CreateTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
 }
 
-func (ts *todoService) FetchTodosList(userId int) *dto.TodosListResponse {
+// NOTE: 1行の入力から日付・タグ・優先度を読み取り、通常のTodoと同じ手順で作成する
+func (ts *todoService) QuickAddTodo(requestParams dto.QuickAddTodoRequest, userId int) *dto.QuickAddTodoResponse {
+	quickAdd := utils.ParseQuickAdd(requestParams.Text, time.Now())
+	parsed := dto.CreateTodoRequest{Title: quickAdd.Title, Priority: quickAdd.Priority, DueAt: quickAdd.DueAt, Tags: quickAdd.Tags}
+
+	result := ts.CreateTodo(parsed, userId)
+	return &dto.QuickAddTodoResponse{Todo: result.Todo, Parsed: parsed, Error: result.Error, ErrorType: result.ErrorType}
+}
+
+func (ts *todoService) FetchTodosList(filter models.TodoFilter, userId int) *dto.TodosListResponse {
+	filter, errorType, err := normalizeTodoFilter(filter)
+	if err != nil {
+		return &dto.TodosListResponse{Todos: []models.Todo{}, Error: err, ErrorType: errorType}
+	}
 	todos := []models.Todo{}
-	error := ts.todoRepository.GetAllTodos(&todos, userId)
+	error := ts.todoRepository.GetTodos(&todos, filter, time.Now(), userId)
 	if error != nil {
 		return &dto.TodosListResponse{Todos: []models.Todo{}, Error: error, ErrorType: "notFound"}
 	}
+	// NOTE: 実行時刻によって結果が変わる条件ではTodoの更新日時で鮮度を判定できないため、最終更新日時を返さずETagのみで検証させる
+	lastModifiedAt := time.Time{}
+	if !filter.DependsOnNow() {
+		if err := ts.todoRepository.GetTodosLastModifiedAt(&lastModifiedAt, userId); err != nil {
+			return &dto.TodosListResponse{Todos: []models.Todo{}, Error: err, ErrorType: "internalServerError"}
+		}
+	}
 
-	return &dto.TodosListResponse{Todos: todos, Error: nil, ErrorType: ""}
+	return &dto.TodosListResponse{Todos: todos, ETag: todosETag(todos), LastModifiedAt: lastModifiedAt, Error: nil, ErrorType: ""}
 }
 
 func (ts *todoService) FetchTodo(id int, userId int) *dto.FetchTodoResponse {
 	todo := models.Todo{}
-	error := ts.todoRepository.GetTodoById(&todo, id, userId)
-	if error != nil {
-		return &dto.FetchTodoResponse{Todo: models.Todo{}, Error: error, ErrorType: "notFound"}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionRead); err != nil {
+		return &dto.FetchTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
 	}
 
 	return &dto.FetchTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
 }
 
+func (ts *todoService) FetchAssignedTodosList(userId int) *dto.AssignedTodosListResponse {
+	todos := []models.Todo{}
+	if err := ts.todoRepository.GetAssignedTodos(&todos, userId); err != nil {
+		return &dto.AssignedTodosListResponse{Todos: []models.Todo{}, Error: err, ErrorType: "internalServerError"}
+	}
+
+	return &dto.AssignedTodosListResponse{Todos: todos, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) AssignTodo(id int, requestParams dto.AssignTodoRequest, userId int) *dto.AssignTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionManage); err != nil {
+		return &dto.AssignTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	if requestParams.AssigneeID != nil {
+		assignee := models.User{}
+		if err := ts.userRepository.GetUserById(&assignee, *requestParams.AssigneeID); err != nil {
+			return &dto.AssignTodoResponse{Todo: todo, Error: fmt.Errorf("assignee not found"), ErrorType: "badRequest"}
+		}
+	}
+
+	before := models.NewTodoSnapshot(todo)
+	if err := ts.todoRepository.AssignTodo(&todo, requestParams.AssigneeID); err != nil {
+		return &dto.AssignTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	if err := ts.recordRevision(before, todo, models.TodoRevisionActionAssign, userId); err != nil {
+		return &dto.AssignTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	// NOTE: 担当者が変わった場合のみ新しい担当者に通知する
+	if todo.AssigneeID != nil && (before.AssigneeID == nil || *before.AssigneeID != *todo.AssigneeID) {
+		ts.publisher.publish(models.NotificationTypeAssigned, []int{*todo.AssigneeID}, userId, todo, "Assigned to you: "+todo.Title, "")
+	}
+	return &dto.AssignTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) FetchSharedTodosList(userId int) *dto.SharedTodosListResponse {
+	todos := []models.Todo{}
+	if err := ts.todoRepository.GetSharedTodos(&todos, userId); err != nil {
+		return &dto.SharedTodosListResponse{Todos: []models.Todo{}, Error: err, ErrorType: "internalServerError"}
+	}
+
+	return &dto.SharedTodosListResponse{Todos: todos, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) ShareTodo(id int, requestParams dto.ShareTodoRequest, userId int) *dto.ShareTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionManage); err != nil {
+		return &dto.ShareTodoResponse{Share: models.TodoShare{}, Error: err, ErrorType: errorType}
+	}
+
+	share := models.TodoShare{TodoID: todo.ID, UserID: requestParams.UserID, Role: requestParams.Role}
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(share)
+	if validationErrors != nil {
+		return &dto.ShareTodoResponse{Share: share, Error: validationErrors, ErrorType: "validationError"}
+	}
+	if share.UserID == todo.UserID {
+		return &dto.ShareTodoResponse{Share: share, Error: fmt.Errorf("cannot share todo with its owner"), ErrorType: "badRequest"}
+	}
+	collaborator := models.User{}
+	if err := ts.userRepository.GetUserById(&collaborator, share.UserID); err != nil {
+		return &dto.ShareTodoResponse{Share: share, Error: fmt.Errorf("user not found"), ErrorType: "badRequest"}
+	}
+
+	if err := ts.todoShareRepository.SaveTodoShare(&share); err != nil {
+		return &dto.ShareTodoResponse{Share: share, Error: err, ErrorType: "internalServerError"}
+	}
+	ts.publisher.publish(models.NotificationTypeShared, []int{share.UserID}, userId, todo, "Shared with you: "+todo.Title, "role: "+share.Role)
+	return &dto.ShareTodoResponse{Share: share, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) FetchTodoShares(id int, userId int) *dto.TodoSharesResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionRead); err != nil {
+		return &dto.TodoSharesResponse{Shares: []models.TodoShare{}, Error: err, ErrorType: errorType}
+	}
+
+	shares := []models.TodoShare{}
+	if err := ts.todoShareRepository.GetTodoShares(&shares, todo.ID); err != nil {
+		return &dto.TodoSharesResponse{Shares: []models.TodoShare{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TodoSharesResponse{Shares: shares, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) RevokeTodoShare(id int, shareUserId int, userId int) *dto.RevokeTodoShareResponse {
+	todo := models.Todo{}
+	// NOTE: 共有されたユーザ自身は権限に関わらず共有を解除できる
+	permission := todoPermissionManage
+	if shareUserId == userId {
+		permission = todoPermissionRead
+	}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, permission); err != nil {
+		return &dto.RevokeTodoShareResponse{Error: err, ErrorType: errorType}
+	}
+
+	share := models.TodoShare{}
+	if err := ts.todoShareRepository.GetTodoShare(&share, todo.ID, shareUserId); err != nil {
+		return &dto.RevokeTodoShareResponse{Error: err, ErrorType: "notFound"}
+	}
+	if err := ts.todoShareRepository.DeleteTodoShare(&share); err != nil {
+		return &dto.RevokeTodoShareResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.RevokeTodoShareResponse{Error: nil, ErrorType: ""}
+}
+
 func (ts *todoService) UpdateTodo(id int, requestParams dto.UpdateTodoRequest, userId int) *dto.UpdateTodoResponse {
 	todo := models.Todo{}
-	error := ts.todoRepository.GetTodoById(&todo, id, userId)
-	if error != nil {
-		return &dto.UpdateTodoResponse{Todo: models.Todo{}, Error: error, ErrorType: "notFound"}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionEdit); err != nil {
+		return &dto.UpdateTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+
+	return ts.updateTodo(todo, requestParams, userId)
+}
+
+func (ts *todoService) PatchTodo(id int, requestParams dto.PatchTodoRequest, userId int) *dto.PatchTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionEdit); err != nil {
+		return &dto.PatchTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
 	}
 
+	if !utils.MatchETag(requestParams.IfMatch, utils.FormatETag(todo.Version)) {
+		return &dto.PatchTodoResponse{Todo: todo, Error: repositories.ErrTodoVersionConflict, ErrorType: "preconditionFailed"}
+	}
+
+	// NOTE: 現在のTodoにPatchを適用し、その結果を更新内容とする
+	updateParams, err := ts.applyTodoPatch(todo, requestParams)
+	if err != nil {
+		return &dto.PatchTodoResponse{Todo: todo, Error: err, ErrorType: "badRequest"}
+	}
+	updateParams.IfMatch = requestParams.IfMatch
+
+	result := ts.updateTodo(todo, updateParams, userId)
+	return &dto.PatchTodoResponse{Todo: result.Todo, Error: result.Error, ErrorType: result.ErrorType}
+}
+
+func (ts *todoService) updateTodo(todo models.Todo, requestParams dto.UpdateTodoRequest, userId int) *dto.UpdateTodoResponse {
+	if !utils.MatchETag(requestParams.IfMatch, utils.FormatETag(todo.Version)) {
+		return &dto.UpdateTodoResponse{Todo: todo, Error: repositories.ErrTodoVersionConflict, ErrorType: "preconditionFailed"}
+	}
+
+	before := models.NewTodoSnapshot(todo)
+	previousRecurrenceRule := todo.RecurrenceRule
 	todo.Title = requestParams.Title
 	todo.Content = requestParams.Content
+	todo.Priority = requestParams.Priority
+	todo.DueAt = requestParams.DueAt
+	todo.RecurrenceRule = requestParams.RecurrenceRule
 	// NOTE: バリデーションチェック
 	validate := validator.New()
 	validationErrors := validate.Struct(todo)
 	if validationErrors != nil {
 		return &dto.UpdateTodoResponse{Todo: todo, Error: validationErrors, ErrorType: "validationError"}
 	}
+	if err := ts.setRecurrenceStartAt(&todo, previousRecurrenceRule); err != nil {
+		return &dto.UpdateTodoResponse{Todo: todo, Error: err, ErrorType: "badRequest"}
+	}
 
 	// NOTE: Update処理
 	updateError := ts.todoRepository.UpdateTodo(&todo)
+	if errors.Is(updateError, repositories.ErrTodoVersionConflict) {
+		return &dto.UpdateTodoResponse{Todo: todo, Error: updateError, ErrorType: "preconditionFailed"}
+	}
 	if updateError != nil {
 		return &dto.UpdateTodoResponse{Todo: todo, Error: updateError, ErrorType: "internalServerError"}
 	}
+	if err := ts.recordRevision(before, todo, models.TodoRevisionActionUpdate, userId); err != nil {
+		return &dto.UpdateTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
 	return &dto.UpdateTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
 }
 
-func (ts *todoService) DeleteTodo(id int, userId int) *dto.DeleteTodoResponse {
+func (ts *todoService) DeleteTodo(id int, requestParams dto.DeleteTodoRequest, userId int) *dto.DeleteTodoResponse {
 	todo := models.Todo{}
-	error := ts.todoRepository.GetTodoById(&todo, id, userId)
-	if error != nil {
-		return &dto.DeleteTodoResponse{Error: error, ErrorType: "notFound"}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionManage); err != nil {
+		return &dto.DeleteTodoResponse{Error: err, ErrorType: errorType}
+	}
+	if !utils.MatchETag(requestParams.IfMatch, utils.FormatETag(todo.Version)) {
+		return &dto.DeleteTodoResponse{Error: repositories.ErrTodoVersionConflict, ErrorType: "preconditionFailed"}
 	}
 
 	deleteError := ts.todoRepository.DeleteTodo(&todo)
+	if errors.Is(deleteError, repositories.ErrTodoVersionConflict) {
+		return &dto.DeleteTodoResponse{Error: deleteError, ErrorType: "preconditionFailed"}
+	}
 	if deleteError != nil {
 		return &dto.DeleteTodoResponse{Error: deleteError, ErrorType: "internalServerError"}
 	}
 	return &dto.DeleteTodoResponse{Error: nil, ErrorType: ""}
 }
+
+func (ts *todoService) MoveTodo(id int, requestParams dto.MoveTodoRequest, userId int) *dto.MoveTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionEdit); err != nil {
+		return &dto.MoveTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	if requestParams.BeforeID == nil && requestParams.AfterID == nil {
+		return &dto.MoveTodoResponse{Todo: todo, Error: fmt.Errorf("before_id or after_id is required"), ErrorType: "badRequest"}
+	}
+	if (requestParams.BeforeID != nil && *requestParams.BeforeID == id) || (requestParams.AfterID != nil && *requestParams.AfterID == id) {
+		return &dto.MoveTodoResponse{Todo: todo, Error: fmt.Errorf("cannot move todo relative to itself"), ErrorType: "badRequest"}
+	}
+
+	position, errorType, err := ts.calculatePosition(todo, requestParams, userId)
+	if err != nil {
+		return &dto.MoveTodoResponse{Todo: todo, Error: err, ErrorType: errorType}
+	}
+	// NOTE: 振り直しでバージョンが進んでいる可能性があるため取得し直す
+	if err := ts.todoRepository.FindTodoById(&todo, id); err != nil {
+		return &dto.MoveTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	if err := ts.todoRepository.MoveTodo(&todo, position); err != nil {
+		return &dto.MoveTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.MoveTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) CompleteTodo(id int, requestParams dto.CompleteTodoRequest, userId int) *dto.CompleteTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionStatus); err != nil {
+		return &dto.CompleteTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	if todo.Status != models.TodoStatusDone && todo.Blocked {
+		return &dto.CompleteTodoResponse{Todo: todo, Error: errTodoBlocked, ErrorType: "conflict"}
+	}
+
+	// NOTE: 未完了の繰り返しTodoを完了する場合は次回分を生成する
+	var nextTodo *models.Todo
+	if todo.Status != models.TodoStatusDone {
+		nextTodo = ts.buildNextRecurringTodo(todo)
+	}
+
+	before := models.NewTodoSnapshot(todo)
+	completeError := ts.todoRepository.CompleteTodo(&todo, requestParams.CompleteChecklistItems, nextTodo)
+	if completeError != nil {
+		return &dto.CompleteTodoResponse{Todo: todo, Error: completeError, ErrorType: "internalServerError"}
+	}
+	if err := ts.recordRevision(before, todo, models.TodoRevisionActionComplete, userId); err != nil {
+		return &dto.CompleteTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	if nextTodo != nil {
+		if err := ts.recordRevision(models.TodoSnapshot{}, *nextTodo, models.TodoRevisionActionCreate, userId); err != nil {
+			return &dto.CompleteTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+		}
+	}
+	return &dto.CompleteTodoResponse{Todo: todo, NextTodo: nextTodo, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) ReopenTodo(id int, userId int) *dto.ReopenTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionStatus); err != nil {
+		return &dto.ReopenTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+
+	before := models.NewTodoSnapshot(todo)
+	reopenError := ts.todoRepository.ReopenTodo(&todo)
+	if reopenError != nil {
+		return &dto.ReopenTodoResponse{Todo: todo, Error: reopenError, ErrorType: "internalServerError"}
+	}
+	if err := ts.recordRevision(before, todo, models.TodoRevisionActionReopen, userId); err != nil {
+		return &dto.ReopenTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.ReopenTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+// NOTE: 一覧から外すだけのため、アーカイブの操作は作成者のみ可能とし変更履歴には記録しない
+func (ts *todoService) ArchiveTodo(id int, userId int) *dto.ArchiveTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionManage); err != nil {
+		return &dto.ArchiveTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	if todo.Status != models.TodoStatusDone {
+		return &dto.ArchiveTodoResponse{Todo: todo, Error: fmt.Errorf("only completed todos can be archived"), ErrorType: "badRequest"}
+	}
+	if todo.ArchivedAt != nil {
+		return &dto.ArchiveTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+	}
+
+	if err := ts.todoRepository.ArchiveTodo(&todo); err != nil {
+		return &dto.ArchiveTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.ArchiveTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) UnarchiveTodo(id int, userId int) *dto.UnarchiveTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionManage); err != nil {
+		return &dto.UnarchiveTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	if todo.ArchivedAt == nil {
+		return &dto.UnarchiveTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+	}
+
+	if err := ts.todoRepository.UnarchiveTodo(&todo); err != nil {
+		return &dto.UnarchiveTodoResponse{Todo: todo, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.UnarchiveTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) FetchTodoOccurrences(id int, count int, userId int) *dto.TodoOccurrencesResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionRead); err != nil {
+		return &dto.TodoOccurrencesResponse{Occurrences: []time.Time{}, Error: err, ErrorType: errorType}
+	}
+	if todo.RecurrenceRule == "" || todo.DueAt == nil {
+		return &dto.TodoOccurrencesResponse{Occurrences: []time.Time{}, Error: fmt.Errorf("繰り返しが設定されていないTodoです"), ErrorType: "badRequest"}
+	}
+
+	rule, err := utils.ParseRecurrenceRule(todo.RecurrenceRule)
+	if err != nil {
+		return &dto.TodoOccurrencesResponse{Occurrences: []time.Time{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TodoOccurrencesResponse{Occurrences: rule.Occurrences(ts.recurrenceStartAt(todo), *todo.DueAt, count), Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) FetchTrashedTodosList(userId int) *dto.TrashedTodosListResponse {
+	todos := []models.Todo{}
+	error := ts.todoRepository.GetTrashedTodos(&todos, userId)
+	if error != nil {
+		return &dto.TrashedTodosListResponse{Todos: []models.Todo{}, Error: error, ErrorType: "internalServerError"}
+	}
+
+	return &dto.TrashedTodosListResponse{Todos: todos, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) RestoreTodo(id int, userId int) *dto.RestoreTodoResponse {
+	todo := models.Todo{}
+	error := ts.todoRepository.GetTrashedTodoById(&todo, id, userId)
+	if error != nil {
+		return &dto.RestoreTodoResponse{Todo: models.Todo{}, Error: error, ErrorType: "notFound"}
+	}
+
+	restoreError := ts.todoRepository.RestoreTodo(&todo)
+	if restoreError != nil {
+		return &dto.RestoreTodoResponse{Todo: todo, Error: restoreError, ErrorType: "internalServerError"}
+	}
+	return &dto.RestoreTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) PurgeTodo(id int, userId int) *dto.PurgeTodoResponse {
+	todo := models.Todo{}
+	error := ts.todoRepository.GetTrashedTodoById(&todo, id, userId)
+	if error != nil {
+		return &dto.PurgeTodoResponse{Error: error, ErrorType: "notFound"}
+	}
+
+	purgeError := ts.todoRepository.PurgeTodo(&todo)
+	if purgeError != nil {
+		return &dto.PurgeTodoResponse{Error: purgeError, ErrorType: "internalServerError"}
+	}
+	return &dto.PurgeTodoResponse{Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) PurgeTrash(userId int) *dto.PurgeTrashResponse {
+	purgedCount, error := ts.todoRepository.PurgeTrashedTodos(userId)
+	if error != nil {
+		return &dto.PurgeTrashResponse{PurgedCount: 0, Error: error, ErrorType: "internalServerError"}
+	}
+
+	return &dto.PurgeTrashResponse{PurgedCount: purgedCount, Error: nil, ErrorType: ""}
+}
+
+// NOTE: 保持期間を過ぎたゴミ箱のTodoを全ユーザ分まとめて物理削除する
+func (ts *todoService) PurgeExpiredTrash(retention time.Duration) *dto.PurgeTrashResponse {
+	purgedCount, error := ts.todoRepository.PurgeTodosDeletedBefore(time.Now().Add(-retention))
+	if error != nil {
+		return &dto.PurgeTrashResponse{PurgedCount: 0, Error: error, ErrorType: "internalServerError"}
+	}
+
+	return &dto.PurgeTrashResponse{PurgedCount: purgedCount, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) FetchTodoHistory(id int, userId int) *dto.TodoHistoryResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionRead); err != nil {
+		return &dto.TodoHistoryResponse{Revisions: []models.TodoRevision{}, Error: err, ErrorType: errorType}
+	}
+
+	revisions := []models.TodoRevision{}
+	if err := ts.todoRevisionRepository.GetTodoRevisions(&revisions, todo.ID); err != nil {
+		return &dto.TodoHistoryResponse{Revisions: []models.TodoRevision{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TodoHistoryResponse{Revisions: revisions, Error: nil, ErrorType: ""}
+}
+
+func (ts *todoService) RevertTodo(id int, revisionNumber int, userId int) *dto.RevertTodoResponse {
+	todo := models.Todo{}
+	if errorType, err := ts.authorizer.authorizeTodo(&todo, id, userId, todoPermissionEdit); err != nil {
+		return &dto.RevertTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: errorType}
+	}
+	revision := models.TodoRevision{}
+	if err := ts.todoRevisionRepository.GetTodoRevision(&revision, todo.ID, revisionNumber); err != nil {
+		return &dto.RevertTodoResponse{Todo: models.Todo{}, Error: err, ErrorType: "notFound"}
+	}
+
+	// NOTE: 指定された版の時点の内容に戻す
+	before := models.NewTodoSnapshot(todo)
+	previousRecurrenceRule := todo.RecurrenceRule
+	todo.Title = revision.Snapshot.Title
+	todo.Content = revision.Snapshot.Content
+	todo.Priority = revision.Snapshot.Priority
+	todo.DueAt = revision.Snapshot.DueAt
+	todo.RecurrenceRule = revision.Snapshot.RecurrenceRule
+	if todo.Status != revision.Snapshot.Status {
+		if revision.Snapshot.Status == models.TodoStatusDone && todo.Blocked {
+			return &dto.RevertTodoResponse{Todo: todo, Error: errTodoBlocked, ErrorType: "conflict"}
+		}
+		todo.Status = revision.Snapshot.Status
+		todo.CompletedAt = nil
+		todo.ArchivedAt = nil
+		if todo.Status == models.TodoStatusDone {
+			completedAt := time.Now()
+			todo.CompletedAt = &completedAt
+		}
+	}
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(todo)
+	if validationErrors != nil {
+		return &dto.RevertTodoResponse{Todo: todo, Error: validationErrors, ErrorType: "validationError"}
+	}
+	if err := ts.setRecurrenceStartAt(&todo, previousRecurrenceRule); err != nil {
+		return &dto.RevertTodoResponse{Todo: todo, Error: err, ErrorType: "badRequest"}
+	}
+
+	// NOTE: 履歴が残らないまま戻されることが無いよう、更新と履歴の記録を1つのトランザクションで実行する
+	updateError := ts.transactionRepository.Transaction(func(tx repositories.TransactionRepository) error {
+		txTodoService := newTodoService(tx.TodoRepository(), tx.TodoRevisionRepository(), tx.UserRepository(), tx.TodoShareRepository(), tx.NotificationRepository(), tx.UserSettingRepository(), tx)
+		if err := txTodoService.todoRepository.UpdateTodo(&todo); err != nil {
+			return err
+		}
+		return txTodoService.recordRevision(before, todo, models.TodoRevisionActionRevert, userId)
+	})
+	if errors.Is(updateError, repositories.ErrTodoVersionConflict) {
+		return &dto.RevertTodoResponse{Todo: todo, Error: updateError, ErrorType: "preconditionFailed"}
+	}
+	if updateError != nil {
+		return &dto.RevertTodoResponse{Todo: todo, Error: updateError, ErrorType: "internalServerError"}
+	}
+	return &dto.RevertTodoResponse{Todo: todo, Error: nil, ErrorType: ""}
+}
+
+// NOTE: Patchの対象はPUTで更新できる項目のみとし、nullや削除された項目はゼロ値(未設定)として扱う
+func (ts *todoService) applyTodoPatch(todo models.Todo, requestParams dto.PatchTodoRequest) (dto.UpdateTodoRequest, error) {
+	document, err := json.Marshal(dto.UpdateTodoRequest{
+		Title:          todo.Title,
+		Content:        todo.Content,
+		Priority:       todo.Priority,
+		DueAt:          todo.DueAt,
+		RecurrenceRule: todo.RecurrenceRule,
+	})
+	if err != nil {
+		return dto.UpdateTodoRequest{}, err
+	}
+
+	var patched []byte
+	switch requestParams.PatchType {
+	case dto.PatchTypeMergePatch:
+		patched, err = jsonpatch.MergePatch(document, requestParams.Patch)
+	case dto.PatchTypeJSONPatch:
+		var patch jsonpatch.Patch
+		patch, err = jsonpatch.DecodePatch(requestParams.Patch)
+		if err == nil {
+			patched, err = patch.Apply(document)
+		}
+	default:
+		err = fmt.Errorf("unsupported patch type: %s", requestParams.PatchType)
+	}
+	if err != nil {
+		return dto.UpdateTodoRequest{}, fmt.Errorf("invalid patch: %w", err)
+	}
+
+	updateParams := dto.UpdateTodoRequest{}
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&updateParams); err != nil {
+		return dto.UpdateTodoRequest{}, fmt.Errorf("invalid patch: %w", err)
+	}
+	return updateParams, nil
+}
+
+// NOTE: 移動先の前後のTodoの間の並び順を算出する(未設定・重複している場合や長くなりすぎた場合は振り直してから再計算する)
+// NOTE: 並び順は作成者の一覧におけるものとし、共有されたTodoも作成者の一覧の中で移動する
+func (ts *todoService) calculatePosition(todo models.Todo, requestParams dto.MoveTodoRequest, userId int) (string, string, error) {
+	for rebalanced := false; ; rebalanced = true {
+		lower, upper, errorType, err := ts.positionAnchors(todo, requestParams, userId)
+		if err != nil {
+			return "", errorType, err
+		}
+
+		needsRebalance := (lower.ID != 0 && lower.Position == "") || (upper.ID != 0 && upper.Position == "")
+		position := ""
+		if !needsRebalance {
+			position, err = utils.RankBetween(lower.Position, upper.Position)
+			needsRebalance = err != nil || len(position) > models.TodoPositionMaxLength
+		}
+		if !needsRebalance {
+			return position, "", nil
+		}
+		if rebalanced {
+			if err != nil {
+				return "", "badRequest", fmt.Errorf("after_id must be positioned before before_id")
+			}
+			return "", "internalServerError", fmt.Errorf("failed to calculate todo position")
+		}
+
+		if err := ts.todoRepository.RebalanceTodoPositions(todo.UserID); err != nil {
+			return "", "internalServerError", err
+		}
+	}
+}
+
+// NOTE: 移動先の直前(lower)と直後(upper)のTodoを返す(端の場合はゼロ値)
+func (ts *todoService) positionAnchors(todo models.Todo, requestParams dto.MoveTodoRequest, userId int) (models.Todo, models.Todo, string, error) {
+	lower := models.Todo{}
+	upper := models.Todo{}
+	if requestParams.AfterID != nil {
+		if errorType, err := ts.authorizePositionAnchor(&lower, *requestParams.AfterID, todo, userId); err != nil {
+			return lower, upper, errorType, err
+		}
+	}
+	if requestParams.BeforeID != nil {
+		if errorType, err := ts.authorizePositionAnchor(&upper, *requestParams.BeforeID, todo, userId); err != nil {
+			return lower, upper, errorType, err
+		}
+	}
+
+	if requestParams.BeforeID == nil {
+		if err := ts.todoRepository.GetNextTodoByPosition(&upper, lower.Position, todo.ID, todo.UserID); err != nil {
+			return lower, upper, "internalServerError", err
+		}
+	}
+	if requestParams.AfterID == nil {
+		if err := ts.todoRepository.GetPreviousTodoByPosition(&lower, upper.Position, todo.ID, todo.UserID); err != nil {
+			return lower, upper, "internalServerError", err
+		}
+	}
+	return lower, upper, "", nil
+}
+
+// NOTE: 移動先の前後に指定するTodoは、参照できかつ移動するTodoと同じ作成者の一覧にあるものに限る
+func (ts *todoService) authorizePositionAnchor(anchor *models.Todo, id int, todo models.Todo, userId int) (string, error) {
+	if errorType, err := ts.authorizer.authorizeTodo(anchor, id, userId, todoPermissionRead); err != nil {
+		return errorType, err
+	}
+	if anchor.UserID != todo.UserID {
+		return "badRequest", errTodoPositionAnotherList
+	}
+	return "", nil
+}
+
+// NOTE: 変更内容を履歴として記録する(変更が無い場合は記録しない)
+func (ts *todoService) recordRevision(before models.TodoSnapshot, todo models.Todo, action string, userId int) error {
+	after := models.NewTodoSnapshot(todo)
+	changes := before.Diff(after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	revision := models.TodoRevision{TodoID: todo.ID, UserID: userId, Action: action, Changes: changes, Snapshot: after}
+	return ts.todoRevisionRepository.CreateTodoRevision(&revision)
+}
+
+// NOTE: 繰り返しルールを検証し、ルールが変わった場合は起点日時を期日に合わせる
+func (ts *todoService) setRecurrenceStartAt(todo *models.Todo, previousRecurrenceRule string) error {
+	if todo.RecurrenceRule == "" {
+		todo.RecurrenceStartAt = nil
+		return nil
+	}
+	if _, err := utils.ParseRecurrenceRule(todo.RecurrenceRule); err != nil {
+		return fmt.Errorf("recurrence_ruleが不正です: %v", err)
+	}
+
+	if todo.RecurrenceRule != previousRecurrenceRule || todo.RecurrenceStartAt == nil {
+		todo.RecurrenceStartAt = todo.DueAt
+	}
+	return nil
+}
+
+// NOTE: 繰り返しTodoの次回分を組み立てる(繰り返しが終了している場合はnil)
+func (ts *todoService) buildNextRecurringTodo(todo models.Todo) *models.Todo {
+	if todo.RecurrenceRule == "" || todo.DueAt == nil {
+		return nil
+	}
+	rule, err := utils.ParseRecurrenceRule(todo.RecurrenceRule)
+	if err != nil {
+		return nil
+	}
+	recurrenceStartAt := ts.recurrenceStartAt(todo)
+	nextDueAt := rule.Next(recurrenceStartAt, *todo.DueAt)
+	if nextDueAt == nil {
+		return nil
+	}
+
+	nextTodo := models.Todo{
+		Title:             todo.Title,
+		Content:           todo.Content,
+		Status:            models.TodoStatusTodo,
+		Priority:          todo.Priority,
+		DueAt:             nextDueAt,
+		RecurrenceRule:    todo.RecurrenceRule,
+		RecurrenceStartAt: &recurrenceStartAt,
+		UserID:            todo.UserID,
+		AssigneeID:        todo.AssigneeID,
+	}
+	// NOTE: チェックリストは未完了の状態で引き継ぐ
+	for _, item := range todo.ChecklistItems {
+		nextTodo.ChecklistItems = append(nextTodo.ChecklistItems, models.ChecklistItem{Title: item.Title, Position: item.Position})
+	}
+	nextTodo.ChecklistProgress = models.NewChecklistProgress(nextTodo.ChecklistItems)
+	return &nextTodo
+}
+
+func (ts *todoService) recurrenceStartAt(todo models.Todo) time.Time {
+	if todo.RecurrenceStartAt != nil {
+		return *todo.RecurrenceStartAt
+	}
+	return *todo.DueAt
+}
+
+// NOTE: タグ名を正規化した上で絞り込み条件を検証する
+func normalizeTodoFilter(filter models.TodoFilter) (models.TodoFilter, string, error) {
+	tags, err := normalizeTagNames(filter.Tags)
+	if err != nil {
+		return filter, "badRequest", err
+	}
+	filter.Tags = tags
+	validate := validator.New()
+	if validationErrors := validate.Struct(filter); validationErrors != nil {
+		return filter, "validationError", validationErrors
+	}
+	if filter.DueFromDays != nil && filter.DueToDays != nil && *filter.DueFromDays > *filter.DueToDays {
+		return filter, "badRequest", errors.New("due_from_days must be less than or equal to due_to_days")
+	}
+	return filter, "", nil
+}
+
+// NOTE: 一覧のETagは各TodoのIDとバージョンから算出する
+func todosETag(todos []models.Todo) string {
+	hash := sha256.New()
+	for _, todo := range todos {
+		fmt.Fprintf(hash, "%d:%d,", todo.ID, todo.Version)
+	}
+	return "W/\"" + hex.EncodeToString(hash.Sum(nil))[:32] + "\""
+}
@@ -5,10 +5,14 @@ import (
 	"app/models"
 	"app/repositories"
 	"app/test/factories"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 )
 
 type TestTodoServiceSuite struct {
@@ -30,7 +34,13 @@ func (s *TestTodoServiceSuite) SetupTest() {
 	}
 
 	todoRepository := repositories.NewTodoRepository(DbCon)
-	testTodoService = NewTodoService(todoRepository)
+	todoRevisionRepository := repositories.NewTodoRevisionRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	userRepository := repositories.NewUserRepository(DbCon)
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+	transactionRepository := repositories.NewTransactionRepository(DbCon)
+	testTodoService = NewTodoService(todoRepository, todoRevisionRepository, userRepository, todoShareRepository, notificationRepository, userSettingRepository, transactionRepository)
 }
 
 func (s *TestTodoServiceSuite) TearDownTest() {
@@ -54,6 +64,42 @@ func (s *TestTodoServiceSuite) TestCreateTodo() {
 	assert.Equal(s.T(), "test content 1", todo.Content)
 }
 
+func (s *TestTodoServiceSuite) TestCreateTodo_WithChecklistAndTags() {
+	requestParams := dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1", ChecklistItems: []string{"step 1", "step 2"}, Tags: []string{"#Work", "work"}}
+
+	result := testTodoService.CreateTodo(requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), []string{"work"}, result.Todo.Tags)
+	assert.Equal(s.T(), models.ChecklistProgress{Done: 0, Total: 2}, result.Todo.ChecklistProgress)
+
+	// NOTE: チェックリスト・タグが作成されていることを確認
+	todo := models.Todo{}
+	if err := DbCon.Preload("ChecklistItems").Preload("TodoTags").Where("user_id = ?", user.ID).First(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create todo %v", err)
+	}
+	assert.Len(s.T(), todo.ChecklistItems, 2)
+	assert.Equal(s.T(), []string{"work"}, todo.Tags)
+}
+
+func (s *TestTodoServiceSuite) TestQuickAddTodo() {
+	result := testTodoService.QuickAddTodo(dto.QuickAddTodoRequest{Text: "Pay rent tomorrow 9am #home !high"}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "Pay rent", result.Parsed.Title)
+	assert.Equal(s.T(), "Pay rent", result.Todo.Title)
+	assert.Equal(s.T(), models.TodoPriorityHigh, result.Todo.Priority)
+	assert.Equal(s.T(), []string{"home"}, result.Todo.Tags)
+	assert.Equal(s.T(), 9, result.Todo.DueAt.Hour())
+}
+
+func (s *TestTodoServiceSuite) TestQuickAddTodo_ValidationError() {
+	result := testTodoService.QuickAddTodo(dto.QuickAddTodoRequest{Text: "tomorrow #home"}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
 func (s *TestTodoServiceSuite) TestCreateTodo_ValidationError() {
 	requestParams := dto.CreateTodoRequest{Title: "", Content: "test content 1"}
 
@@ -68,6 +114,37 @@ func (s *TestTodoServiceSuite) TestCreateTodo_ValidationError() {
 	assert.NotNil(s.T(), err)
 }
 
+func (s *TestTodoServiceSuite) TestCreateTodo_Recurrence() {
+	dueAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	requestParams := dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1", DueAt: &dueAt, RecurrenceRule: "FREQ=WEEKLY;BYDAY=MO"}
+
+	result := testTodoService.CreateTodo(requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+	assert.Equal(s.T(), "FREQ=WEEKLY;BYDAY=MO", result.Todo.RecurrenceRule)
+	assert.True(s.T(), dueAt.Equal(*result.Todo.RecurrenceStartAt))
+}
+
+func (s *TestTodoServiceSuite) TestCreateTodo_RecurrenceWithoutDueAt() {
+	requestParams := dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1", RecurrenceRule: "FREQ=DAILY"}
+
+	result := testTodoService.CreateTodo(requestParams, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestCreateTodo_InvalidRecurrenceRule() {
+	dueAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	requestParams := dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1", DueAt: &dueAt, RecurrenceRule: "FREQ=YEARLY"}
+
+	result := testTodoService.CreateTodo(requestParams, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
 func (s *TestTodoServiceSuite) TestFetchTodosList() {
 	testTodos := []models.Todo{
 		{Title: "test title 1", Content: "test content 1", UserID: user.ID},
@@ -77,13 +154,29 @@ func (s *TestTodoServiceSuite) TestFetchTodosList() {
 		s.T().Fatalf("failed to create test todos %v", err)
 	}
 
-	result := testTodoService.FetchTodosList(user.ID)
+	result := testTodoService.FetchTodosList(models.TodoFilter{}, user.ID)
 
 	assert.Nil(s.T(), result.Error)
 	assert.Equal(s.T(), "", result.ErrorType)
 	assert.Len(s.T(), result.Todos, 2)
 }
 
+func (s *TestTodoServiceSuite) TestFetchTodosList_Filter() {
+	testTodos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID, Priority: models.TodoPriorityHigh},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID, Priority: models.TodoPriorityLow},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.FetchTodosList(models.TodoFilter{Priorities: []string{models.TodoPriorityHigh}}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Todos, 1)
+	assert.Equal(s.T(), "test title 1", result.Todos[0].Title)
+}
+
 func (s *TestTodoServiceSuite) TestFetchTodo() {
 	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
 	if err := DbCon.Create(&testTodo).Error; err != nil {
@@ -112,6 +205,22 @@ func (s *TestTodoServiceSuite) TestUpdateTodo() {
 	assert.Equal(s.T(), "test updated content 1", result.Todo.Content)
 }
 
+func (s *TestTodoServiceSuite) TestUpdateTodo_FullReplacement() {
+	dueAt := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	created := testTodoService.CreateTodo(dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1", Priority: models.TodoPriorityHigh, DueAt: &dueAt, RecurrenceRule: "FREQ=WEEKLY;BYDAY=MO"}, user.ID)
+
+	requestParams := dto.UpdateTodoRequest{Title: "test updated title 1", Content: "test updated content 1"}
+	result := testTodoService.UpdateTodo(created.Todo.ID, requestParams, user.ID)
+
+	// NOTE: PUTで省略した優先度・期日・繰り返しは空の値に置き換えられること
+	assert.Nil(s.T(), result.Error)
+	fetched := testTodoService.FetchTodo(created.Todo.ID, user.ID)
+	assert.Equal(s.T(), "", fetched.Todo.Priority)
+	assert.Nil(s.T(), fetched.Todo.DueAt)
+	assert.Equal(s.T(), "", fetched.Todo.RecurrenceRule)
+	assert.Nil(s.T(), fetched.Todo.RecurrenceStartAt)
+}
+
 func (s *TestTodoServiceSuite) TestUpdateTodo_ValidationError() {
 	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
 	if err := DbCon.Create(&testTodo).Error; err != nil {
@@ -136,10 +245,727 @@ func (s *TestTodoServiceSuite) TestDeleteTodo() {
 		s.T().Fatalf("failed to create test todos %v", err)
 	}
 
-	result := testTodoService.DeleteTodo(testTodo.ID, user.ID)
+	result := testTodoService.DeleteTodo(testTodo.ID, dto.DeleteTodoRequest{}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	testItem := models.ChecklistItem{TodoID: testTodo.ID, Title: "test item 1", Position: 1}
+	if err := DbCon.Create(&testItem).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	requestParams := dto.CompleteTodoRequest{CompleteChecklistItems: true}
+	result := testTodoService.CompleteTodo(testTodo.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+	assert.Equal(s.T(), models.TodoStatusDone, result.Todo.Status)
+	assert.Equal(s.T(), models.ChecklistProgress{Done: 1, Total: 1}, result.Todo.ChecklistProgress)
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo_NotFound() {
+	result := testTodoService.CompleteTodo(0, dto.CompleteTodoRequest{}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "notFound", result.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo_Recurrence() {
+	dueAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", DueAt: &dueAt, RecurrenceRule: "FREQ=DAILY;INTERVAL=2;COUNT=2", RecurrenceStartAt: &dueAt, UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	testItem := models.ChecklistItem{TodoID: testTodo.ID, Title: "test item 1", Done: true, Position: 1}
+	if err := DbCon.Create(&testItem).Error; err != nil {
+		s.T().Fatalf("failed to create test checklist item %v", err)
+	}
+
+	result := testTodoService.CompleteTodo(testTodo.ID, dto.CompleteTodoRequest{}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	// NOTE: 次回分が未完了のチェックリスト付きで作成されていること
+	assert.NotNil(s.T(), result.NextTodo)
+	assert.True(s.T(), dueAt.AddDate(0, 0, 2).Equal(*result.NextTodo.DueAt))
+	nextTodo := models.Todo{}
+	if err := DbCon.Preload("ChecklistItems").First(&nextTodo, result.NextTodo.ID).Error; err != nil {
+		s.T().Fatalf("failed to fetch next todo %v", err)
+	}
+	assert.Equal(s.T(), models.TodoStatusTodo, nextTodo.Status)
+	assert.Equal(s.T(), models.ChecklistProgress{Done: 0, Total: 1}, nextTodo.ChecklistProgress)
+
+	// NOTE: COUNTに達したら次回分は作成されないこと
+	lastResult := testTodoService.CompleteTodo(nextTodo.ID, dto.CompleteTodoRequest{}, user.ID)
+	assert.Nil(s.T(), lastResult.Error)
+	assert.Nil(s.T(), lastResult.NextTodo)
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodoOccurrences() {
+	dueAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", DueAt: &dueAt, RecurrenceRule: "FREQ=MONTHLY", RecurrenceStartAt: &dueAt, UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.FetchTodoOccurrences(testTodo.ID, 3, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Occurrences, 3)
+	assert.True(s.T(), dueAt.AddDate(0, 1, 0).Equal(result.Occurrences[0]))
+	assert.True(s.T(), dueAt.AddDate(0, 3, 0).Equal(result.Occurrences[2]))
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodoOccurrences_WithoutRecurrence() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.FetchTodoOccurrences(testTodo.ID, 3, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestReopenTodo() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.ReopenTodo(testTodo.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+	assert.Equal(s.T(), models.TodoStatusTodo, result.Todo.Status)
+}
+
+func (s *TestTodoServiceSuite) TestArchiveTodo() {
+	testTodos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.ArchiveTodo(testTodos[0].ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.NotNil(s.T(), result.Todo.ArchivedAt)
+	assert.Len(s.T(), testTodoService.FetchTodosList(models.TodoFilter{}, user.ID).Todos, 1)
+	assert.Len(s.T(), testTodoService.FetchTodosList(models.TodoFilter{IncludeArchived: true}, user.ID).Todos, 2)
+
+	// NOTE: 未完了のTodoはアーカイブできないこと
+	notDone := testTodoService.ArchiveTodo(testTodos[1].ID, user.ID)
+	assert.Equal(s.T(), "badRequest", notDone.ErrorType)
+
+	unarchived := testTodoService.UnarchiveTodo(testTodos[0].ID, user.ID)
+	assert.Nil(s.T(), unarchived.Error)
+	assert.Nil(s.T(), unarchived.Todo.ArchivedAt)
+}
+
+func (s *TestTodoServiceSuite) TestArchiveTodo_Forbidden() {
+	otherUser := models.User{Name: "other", Email: "other@example.com", Password: "password"}
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: testTodo.ID, UserID: otherUser.ID, Role: models.TodoShareRoleEditor}).Error; err != nil {
+		s.T().Fatalf("failed to create test share %v", err)
+	}
+
+	result := testTodoService.ArchiveTodo(testTodo.ID, otherUser.ID)
+
+	assert.Equal(s.T(), "forbidden", result.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestFetchTrashedTodosList() {
+	testTodos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	testTodoService.DeleteTodo(testTodos[1].ID, dto.DeleteTodoRequest{}, user.ID)
+
+	result := testTodoService.FetchTrashedTodosList(user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Todos, 1)
+	assert.Equal(s.T(), testTodos[1].ID, result.Todos[0].ID)
+}
+
+func (s *TestTodoServiceSuite) TestRestoreTodo() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	testTodoService.DeleteTodo(testTodo.ID, dto.DeleteTodoRequest{}, user.ID)
+
+	result := testTodoService.RestoreTodo(testTodo.ID, user.ID)
 
 	assert.Nil(s.T(), result.Error)
 	assert.Equal(s.T(), "", result.ErrorType)
+	assert.Nil(s.T(), testTodoService.FetchTodo(testTodo.ID, user.ID).Error)
+}
+
+func (s *TestTodoServiceSuite) TestRestoreTodo_NotTrashed() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.RestoreTodo(testTodo.ID, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "notFound", result.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestPurgeTodo() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	testTodoService.DeleteTodo(testTodo.ID, dto.DeleteTodoRequest{}, user.ID)
+
+	result := testTodoService.PurgeTodo(testTodo.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+	assert.Len(s.T(), testTodoService.FetchTrashedTodosList(user.ID).Todos, 0)
+}
+
+func (s *TestTodoServiceSuite) TestPurgeExpiredTrash() {
+	testTodos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", UserID: user.ID, DeletedAt: gorm.DeletedAt{Time: time.Now().AddDate(0, 0, -31), Valid: true}},
+		{Title: "test title 2", Content: "test content 2", UserID: user.ID, DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.PurgeExpiredTrash(30 * 24 * time.Hour)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), int64(1), result.PurgedCount)
+	assert.Len(s.T(), testTodoService.FetchTrashedTodosList(user.ID).Todos, 1)
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodoHistory() {
+	createResult := testTodoService.CreateTodo(dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1"}, user.ID)
+	testTodoService.UpdateTodo(createResult.Todo.ID, dto.UpdateTodoRequest{Title: "test updated title 1", Content: "test content 1"}, user.ID)
+	// NOTE: 変更が無い更新は記録されないこと
+	testTodoService.UpdateTodo(createResult.Todo.ID, dto.UpdateTodoRequest{Title: "test updated title 1", Content: "test content 1"}, user.ID)
+
+	result := testTodoService.FetchTodoHistory(createResult.Todo.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Revisions, 2)
+	assert.Equal(s.T(), models.TodoRevisionActionUpdate, result.Revisions[0].Action)
+	assert.Equal(s.T(), user.ID, result.Revisions[0].UserID)
+	assert.Equal(s.T(), map[string]models.TodoFieldChange{"title": {Old: "test title 1", New: "test updated title 1"}}, result.Revisions[0].Changes)
+	assert.Equal(s.T(), models.TodoRevisionActionCreate, result.Revisions[1].Action)
+}
+
+func (s *TestTodoServiceSuite) TestRevertTodo() {
+	createResult := testTodoService.CreateTodo(dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1"}, user.ID)
+	testTodoService.UpdateTodo(createResult.Todo.ID, dto.UpdateTodoRequest{Title: "test updated title 1", Content: "test updated content 1"}, user.ID)
+
+	result := testTodoService.RevertTodo(createResult.Todo.ID, 1, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "test title 1", result.Todo.Title)
+	assert.Equal(s.T(), "test content 1", result.Todo.Content)
+	// NOTE: 復元も履歴として記録されること
+	history := testTodoService.FetchTodoHistory(createResult.Todo.ID, user.ID)
+	assert.Len(s.T(), history.Revisions, 3)
+	assert.Equal(s.T(), models.TodoRevisionActionRevert, history.Revisions[0].Action)
+}
+
+func (s *TestTodoServiceSuite) TestRevertTodo_RevisionNotFound() {
+	createResult := testTodoService.CreateTodo(dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1"}, user.ID)
+
+	result := testTodoService.RevertTodo(createResult.Todo.ID, 99, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "notFound", result.ErrorType)
+}
+
+// NOTE: 取得した直後に別のリクエストで更新された状態を再現するため、取得後にバージョンを進める
+type concurrentlyUpdatedTodoRepository struct {
+	repositories.TodoRepository
+}
+
+func (r concurrentlyUpdatedTodoRepository) FindTodoById(todo *models.Todo, id int) error {
+	if err := r.TodoRepository.FindTodoById(todo, id); err != nil {
+		return err
+	}
+	return DbCon.Model(&models.Todo{}).Where("id = ?", id).Update("version", gorm.Expr("version + 1")).Error
+}
+
+func (s *TestTodoServiceSuite) TestRevertTodo_PreconditionFailed() {
+	createResult := testTodoService.CreateTodo(dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1"}, user.ID)
+	testTodoService.UpdateTodo(createResult.Todo.ID, dto.UpdateTodoRequest{Title: "test updated title 1", Content: "test updated content 1"}, user.ID)
+	todoService := NewTodoService(concurrentlyUpdatedTodoRepository{repositories.NewTodoRepository(DbCon)}, repositories.NewTodoRevisionRepository(DbCon), repositories.NewUserRepository(DbCon), repositories.NewTodoShareRepository(DbCon), repositories.NewNotificationRepository(DbCon), repositories.NewUserSettingRepository(DbCon), repositories.NewTransactionRepository(DbCon))
+
+	result := todoService.RevertTodo(createResult.Todo.ID, 1, user.ID)
+
+	assert.ErrorIs(s.T(), result.Error, repositories.ErrTodoVersionConflict)
+	assert.Equal(s.T(), "preconditionFailed", result.ErrorType)
+	// NOTE: 復元されず、履歴も記録されないこと
+	fetched := testTodoService.FetchTodo(createResult.Todo.ID, user.ID)
+	assert.Equal(s.T(), "test updated title 1", fetched.Todo.Title)
+	assert.Len(s.T(), testTodoService.FetchTodoHistory(createResult.Todo.ID, user.ID).Revisions, 2)
+}
+
+func (s *TestTodoServiceSuite) TestPatchTodo_MergePatch() {
+	dueAt := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", DueAt: &dueAt, UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: 指定した項目のみ更新され、nullを指定した項目はクリアされること
+	requestParams := dto.PatchTodoRequest{PatchType: dto.PatchTypeMergePatch, Patch: []byte(`{"title":"test patched title 1","due_at":null}`)}
+	result := testTodoService.PatchTodo(testTodo.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "", result.ErrorType)
+	assert.Equal(s.T(), "test patched title 1", result.Todo.Title)
+	assert.Equal(s.T(), "test content 1", result.Todo.Content)
+	assert.Nil(s.T(), result.Todo.DueAt)
+}
+
+func (s *TestTodoServiceSuite) TestPatchTodo_JSONPatch() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	requestParams := dto.PatchTodoRequest{PatchType: dto.PatchTypeJSONPatch, Patch: []byte(`[{"op":"test","path":"/title","value":"test title 1"},{"op":"replace","path":"/content","value":"test patched content 1"}]`)}
+	result := testTodoService.PatchTodo(testTodo.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "test title 1", result.Todo.Title)
+	assert.Equal(s.T(), "test patched content 1", result.Todo.Content)
+}
+
+func (s *TestTodoServiceSuite) TestPatchTodo_ValidationError() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: Patch適用後のTodoに対してバリデーションが行われること
+	requestParams := dto.PatchTodoRequest{PatchType: dto.PatchTypeJSONPatch, Patch: []byte(`[{"op":"remove","path":"/title"}]`)}
+	result := testTodoService.PatchTodo(testTodo.ID, requestParams, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestPatchTodo_InvalidPatch() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: 更新対象外の項目や失敗するtest操作はbadRequestとなること
+	unknownField := testTodoService.PatchTodo(testTodo.ID, dto.PatchTodoRequest{PatchType: dto.PatchTypeMergePatch, Patch: []byte(`{"user_id":2}`)}, user.ID)
+	failedTest := testTodoService.PatchTodo(testTodo.ID, dto.PatchTodoRequest{PatchType: dto.PatchTypeJSONPatch, Patch: []byte(`[{"op":"test","path":"/title","value":"other title"}]`)}, user.ID)
+
+	assert.Equal(s.T(), "badRequest", unknownField.ErrorType)
+	assert.Equal(s.T(), "badRequest", failedTest.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestUpdateTodo_PreconditionFailed() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	matched := testTodoService.UpdateTodo(testTodo.ID, dto.UpdateTodoRequest{Title: "test updated title 1", IfMatch: "\"1\""}, user.ID)
+	// NOTE: 更新によりバージョンが進んでいるため、古いETagでの更新は失敗すること
+	stale := testTodoService.UpdateTodo(testTodo.ID, dto.UpdateTodoRequest{Title: "test updated title 2", IfMatch: "\"1\""}, user.ID)
+
+	assert.Nil(s.T(), matched.Error)
+	assert.Equal(s.T(), 2, matched.Todo.Version)
+	assert.NotNil(s.T(), stale.Error)
+	assert.Equal(s.T(), "preconditionFailed", stale.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestDeleteTodo_PreconditionFailed() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.DeleteTodo(testTodo.ID, dto.DeleteTodoRequest{IfMatch: "\"2\""}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "preconditionFailed", result.ErrorType)
+	// NOTE: Todoが削除されていないことを確認
+	assert.Nil(s.T(), testTodoService.FetchTodo(testTodo.ID, user.ID).Error)
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodosList_ETag() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	before := testTodoService.FetchTodosList(models.TodoFilter{}, user.ID)
+	testTodoService.UpdateTodo(testTodo.ID, dto.UpdateTodoRequest{Title: "test updated title 1"}, user.ID)
+	after := testTodoService.FetchTodosList(models.TodoFilter{}, user.ID)
+
+	assert.Nil(s.T(), before.Error)
+	assert.NotEqual(s.T(), "", before.ETag)
+	// NOTE: Todoが更新されるとETagが変わること
+	assert.NotEqual(s.T(), before.ETag, after.ETag)
+	assert.False(s.T(), after.LastModifiedAt.IsZero())
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo() {
+	testTodos := []models.Todo{
+		{Title: "test title 1", UserID: user.ID, Position: "a"},
+		{Title: "test title 2", UserID: user.ID, Position: "b"},
+		{Title: "test title 3", UserID: user.ID, Position: "c"},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: 3件目を先頭へ、1件目を2件目の直後へ移動する
+	toTop := testTodoService.MoveTodo(testTodos[2].ID, dto.MoveTodoRequest{BeforeID: &testTodos[0].ID}, user.ID)
+	afterSecond := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{AfterID: &testTodos[1].ID}, user.ID)
+
+	assert.Nil(s.T(), toTop.Error)
+	assert.Nil(s.T(), afterSecond.Error)
+	result := testTodoService.FetchTodosList(models.TodoFilter{}, user.ID)
+	assert.Equal(s.T(), []string{"test title 3", "test title 2", "test title 1"}, []string{result.Todos[0].Title, result.Todos[1].Title, result.Todos[2].Title})
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo_Between() {
+	testTodos := []models.Todo{
+		{Title: "test title 1", UserID: user.ID, Position: "a"},
+		{Title: "test title 2", UserID: user.ID, Position: "a1"},
+		{Title: "test title 3", UserID: user.ID, Position: "c"},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.MoveTodo(testTodos[2].ID, dto.MoveTodoRequest{AfterID: &testTodos[0].ID, BeforeID: &testTodos[1].ID}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.True(s.T(), "a" < result.Todo.Position && result.Todo.Position < "a1")
+	// NOTE: 移動したTodoのみ更新されていること
+	otherTodo := models.Todo{}
+	DbCon.First(&otherTodo, testTodos[1].ID)
+	assert.Equal(s.T(), 1, otherTodo.Version)
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo_Rebalance() {
+	// NOTE: 並び順が未設定のTodoは振り直してから移動すること
+	testTodos := []models.Todo{
+		{Title: "test title 1", UserID: user.ID},
+		{Title: "test title 2", UserID: user.ID},
+		{Title: "test title 3", UserID: user.ID},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{AfterID: &testTodos[1].ID}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	list := testTodoService.FetchTodosList(models.TodoFilter{}, user.ID)
+	assert.Equal(s.T(), []string{"test title 2", "test title 1", "test title 3"}, []string{list.Todos[0].Title, list.Todos[1].Title, list.Todos[2].Title})
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo_BadRequest() {
+	testTodos := []models.Todo{
+		{Title: "test title 1", UserID: user.ID, Position: "a"},
+		{Title: "test title 2", UserID: user.ID, Position: "b"},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	missingId := testTodos[1].ID + 100
+
+	noAnchor := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{}, user.ID)
+	self := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{AfterID: &testTodos[0].ID}, user.ID)
+	reversed := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{AfterID: &testTodos[1].ID, BeforeID: &testTodos[1].ID}, user.ID)
+	missing := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{AfterID: &missingId}, user.ID)
+
+	assert.Equal(s.T(), "badRequest", noAnchor.ErrorType)
+	assert.Equal(s.T(), "badRequest", self.ErrorType)
+	assert.Equal(s.T(), "badRequest", reversed.ErrorType)
+	assert.Equal(s.T(), "notFound", missing.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo_Shared() {
+	editor := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "editor@example.com"}).(*models.User)
+	viewer := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "viewer@example.com"}).(*models.User)
+	for _, collaborator := range []*models.User{editor, viewer} {
+		if err := DbCon.Create(&collaborator).Error; err != nil {
+			s.T().Fatalf("failed to create test user %v", err)
+		}
+	}
+	testTodos := []models.Todo{
+		{Title: "test title 1", UserID: user.ID, Position: "a"},
+		{Title: "test title 2", UserID: user.ID, Position: "b"},
+		{Title: "test title 3", UserID: editor.ID, Position: "a"},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	shares := []models.TodoShare{
+		{TodoID: testTodos[0].ID, UserID: editor.ID, Role: models.TodoShareRoleEditor},
+		{TodoID: testTodos[1].ID, UserID: editor.ID, Role: models.TodoShareRoleViewer},
+		{TodoID: testTodos[0].ID, UserID: viewer.ID, Role: models.TodoShareRoleViewer},
+		{TodoID: testTodos[1].ID, UserID: viewer.ID, Role: models.TodoShareRoleViewer},
+	}
+	if err := DbCon.Create(&shares).Error; err != nil {
+		s.T().Fatalf("failed to create test shares %v", err)
+	}
+
+	// NOTE: 編集者は作成者の一覧の中で移動できること
+	moved := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{AfterID: &testTodos[1].ID}, editor.ID)
+	assert.Nil(s.T(), moved.Error)
+	result := testTodoService.FetchTodosList(models.TodoFilter{}, user.ID)
+	assert.Equal(s.T(), []string{"test title 2", "test title 1"}, []string{result.Todos[0].Title, result.Todos[1].Title})
+	// NOTE: 別の作成者の一覧にあるTodoの前後には移動できないこと
+	anotherList := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{BeforeID: &testTodos[2].ID}, editor.ID)
+	assert.Equal(s.T(), "badRequest", anotherList.ErrorType)
+	// NOTE: 閲覧者は移動できないこと
+	denied := testTodoService.MoveTodo(testTodos[0].ID, dto.MoveTodoRequest{BeforeID: &testTodos[1].ID}, viewer.ID)
+	assert.Equal(s.T(), "forbidden", denied.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestAssignTodo() {
+	assignee := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "assignee@example.com"}).(*models.User)
+	if err := DbCon.Create(&assignee).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{AssigneeID: &assignee.ID}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), assignee.ID, *result.Todo.AssigneeID)
+	// NOTE: 担当者は一覧・参照・完了ができること
+	assigned := testTodoService.FetchAssignedTodosList(assignee.ID)
+	assert.Len(s.T(), assigned.Todos, 1)
+	assert.Nil(s.T(), testTodoService.FetchTodo(testTodo.ID, assignee.ID).Error)
+	completed := testTodoService.CompleteTodo(testTodo.ID, dto.CompleteTodoRequest{}, assignee.ID)
+	assert.Nil(s.T(), completed.Error)
+	assert.Equal(s.T(), models.TodoStatusDone, completed.Todo.Status)
+	// NOTE: 担当者の変更も履歴に記録されること
+	history := testTodoService.FetchTodoHistory(testTodo.ID, user.ID)
+	assert.Equal(s.T(), models.TodoRevisionActionComplete, history.Revisions[0].Action)
+	assert.Equal(s.T(), assignee.ID, history.Revisions[0].UserID)
+	assert.Equal(s.T(), models.TodoRevisionActionAssign, history.Revisions[1].Action)
+	// NOTE: 新しい担当者に通知されること
+	notifications := []models.Notification{}
+	DbCon.Where("user_id = ?", assignee.ID).Find(&notifications)
+	assert.Len(s.T(), notifications, 1)
+	assert.Equal(s.T(), models.NotificationTypeAssigned, notifications[0].Type)
+	assert.Equal(s.T(), user.ID, *notifications[0].ActorID)
+}
+
+func (s *TestTodoServiceSuite) TestAssignTodo_Notification() {
+	assignee := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "assignee@example.com"}).(*models.User)
+	if err := DbCon.Create(&assignee).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: 自分自身の割り当てと、担当者が変わらない場合は通知しないこと
+	testTodoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{AssigneeID: &user.ID}, user.ID)
+	testTodoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{AssigneeID: &assignee.ID}, user.ID)
+	testTodoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{AssigneeID: &assignee.ID}, user.ID)
+	var count int64
+	DbCon.Model(&models.Notification{}).Count(&count)
+	assert.Equal(s.T(), int64(1), count)
+
+	// NOTE: 受け取らない設定にしている場合は通知しないこと
+	if err := DbCon.Create(&models.UserSetting{UserID: assignee.ID, MutedNotificationTypes: []string{models.NotificationTypeAssigned}}).Error; err != nil {
+		s.T().Fatalf("failed to create test setting %v", err)
+	}
+	testTodoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{}, user.ID)
+	testTodoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{AssigneeID: &assignee.ID}, user.ID)
+	DbCon.Model(&models.Notification{}).Count(&count)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestTodoServiceSuite) TestAssignTodo_Forbidden() {
+	assignee := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "assignee@example.com"}).(*models.User)
+	if err := DbCon.Create(&assignee).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID, AssigneeID: &assignee.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: 担当者は削除・内容の更新・担当者の変更ができないこと
+	deleted := testTodoService.DeleteTodo(testTodo.ID, dto.DeleteTodoRequest{}, assignee.ID)
+	updated := testTodoService.UpdateTodo(testTodo.ID, dto.UpdateTodoRequest{Title: "test updated title 1"}, assignee.ID)
+	reassigned := testTodoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{}, assignee.ID)
+
+	assert.Equal(s.T(), "forbidden", deleted.ErrorType)
+	assert.Equal(s.T(), "forbidden", updated.ErrorType)
+	assert.Equal(s.T(), "forbidden", reassigned.ErrorType)
+	assert.Nil(s.T(), testTodoService.FetchTodo(testTodo.ID, user.ID).Error)
+}
+
+func (s *TestTodoServiceSuite) TestAssignTodo_AssigneeNotFound() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	missingUserId := user.ID + 100
+
+	result := testTodoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{AssigneeID: &missingUserId}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestShareTodo() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: collaborator.ID, Role: models.TodoShareRoleEditor}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), models.TodoShareRoleEditor, result.Share.Role)
+	// NOTE: 共有されたユーザが参照・更新できること
+	shared := testTodoService.FetchSharedTodosList(collaborator.ID)
+	assert.Len(s.T(), shared.Todos, 1)
+	assert.Nil(s.T(), testTodoService.FetchTodo(testTodo.ID, collaborator.ID).Error)
+	updated := testTodoService.UpdateTodo(testTodo.ID, dto.UpdateTodoRequest{Title: "test updated title 1"}, collaborator.ID)
+	assert.Nil(s.T(), updated.Error)
+	assert.Equal(s.T(), "test updated title 1", updated.Todo.Title)
+	shares := testTodoService.FetchTodoShares(testTodo.ID, collaborator.ID)
+	assert.Len(s.T(), shares.Shares, 1)
+	// NOTE: 共有されたユーザに通知されること
+	notifications := []models.Notification{}
+	DbCon.Where("user_id = ?", collaborator.ID).Find(&notifications)
+	assert.Len(s.T(), notifications, 1)
+	assert.Equal(s.T(), models.NotificationTypeShared, notifications[0].Type)
+	assert.Equal(s.T(), testTodo.ID, *notifications[0].TodoID)
+}
+
+func (s *TestTodoServiceSuite) TestShareTodo_NotificationError() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	mockNotificationRepository := new(MockNotificationRepository)
+	mockNotificationRepository.On("CreateNotification", mock.Anything).Return(errors.New("db error"))
+	todoService := NewTodoService(repositories.NewTodoRepository(DbCon), repositories.NewTodoRevisionRepository(DbCon), repositories.NewUserRepository(DbCon), repositories.NewTodoShareRepository(DbCon), mockNotificationRepository, repositories.NewUserSettingRepository(DbCon), repositories.NewTransactionRepository(DbCon))
+
+	shared := todoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: collaborator.ID, Role: models.TodoShareRoleEditor}, user.ID)
+	assigned := todoService.AssignTodo(testTodo.ID, dto.AssignTodoRequest{AssigneeID: &collaborator.ID}, user.ID)
+
+	// NOTE: 通知の記録に失敗しても共有・担当者の変更は成功とすること
+	assert.Nil(s.T(), shared.Error)
+	assert.Nil(s.T(), assigned.Error)
+	mockNotificationRepository.AssertNumberOfCalls(s.T(), "CreateNotification", 2)
+	assert.Len(s.T(), todoService.FetchSharedTodosList(collaborator.ID).Todos, 1)
+}
+
+func (s *TestTodoServiceSuite) TestShareTodo_Permissions() {
+	viewer := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "viewer@example.com"}).(*models.User)
+	editor := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "editor@example.com"}).(*models.User)
+	owner := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "owner@example.com"}).(*models.User)
+	for _, collaborator := range []*models.User{viewer, editor, owner} {
+		if err := DbCon.Create(&collaborator).Error; err != nil {
+			s.T().Fatalf("failed to create test user %v", err)
+		}
+	}
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: viewer.ID, Role: models.TodoShareRoleViewer}, user.ID)
+	testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: editor.ID, Role: models.TodoShareRoleEditor}, user.ID)
+	testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: owner.ID, Role: models.TodoShareRoleOwner}, user.ID)
+
+	// NOTE: 閲覧者は参照のみ可能なこと
+	assert.Nil(s.T(), testTodoService.FetchTodo(testTodo.ID, viewer.ID).Error)
+	assert.Equal(s.T(), "forbidden", testTodoService.CompleteTodo(testTodo.ID, dto.CompleteTodoRequest{}, viewer.ID).ErrorType)
+	assert.Equal(s.T(), "forbidden", testTodoService.UpdateTodo(testTodo.ID, dto.UpdateTodoRequest{Title: "test updated title 1"}, viewer.ID).ErrorType)
+	// NOTE: 編集者は共有の管理・削除ができないこと
+	assert.Equal(s.T(), "forbidden", testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: viewer.ID, Role: models.TodoShareRoleEditor}, editor.ID).ErrorType)
+	assert.Equal(s.T(), "forbidden", testTodoService.DeleteTodo(testTodo.ID, dto.DeleteTodoRequest{}, editor.ID).ErrorType)
+	// NOTE: 共有された所有者は共有の管理ができること
+	assert.Nil(s.T(), testTodoService.RevokeTodoShare(testTodo.ID, viewer.ID, owner.ID).Error)
+	assert.Equal(s.T(), "notFound", testTodoService.FetchTodo(testTodo.ID, viewer.ID).ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestShareTodo_BadRequest() {
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	missingUserId := user.ID + 100
+
+	invalidRole := testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: missingUserId, Role: "admin"}, user.ID)
+	ownerShare := testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: user.ID, Role: models.TodoShareRoleViewer}, user.ID)
+	missingUser := testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: missingUserId, Role: models.TodoShareRoleViewer}, user.ID)
+
+	assert.Equal(s.T(), "validationError", invalidRole.ErrorType)
+	assert.Equal(s.T(), "badRequest", ownerShare.ErrorType)
+	assert.Equal(s.T(), "badRequest", missingUser.ErrorType)
+}
+
+func (s *TestTodoServiceSuite) TestRevokeTodoShare_Self() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "collaborator@example.com"}).(*models.User)
+	if err := DbCon.Create(&collaborator).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	testTodoService.ShareTodo(testTodo.ID, dto.ShareTodoRequest{UserID: collaborator.ID, Role: models.TodoShareRoleViewer}, user.ID)
+
+	// NOTE: 閲覧者でも自身の共有は解除できること
+	result := testTodoService.RevokeTodoShare(testTodo.ID, collaborator.ID, collaborator.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), testTodoService.FetchSharedTodosList(collaborator.ID).Todos, 0)
 }
 
 func TestTodoService(t *testing.T) {
@@ -3,7 +3,9 @@ package services
 import (
 	"app/dto"
 	"app/models"
+	"app/repositories"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -28,11 +30,36 @@ func (_m *MockTodoRepository) GetAllTodos(todos *[]models.Todo, userId int) erro
 	return ret.Error(0)
 }
 
+func (_m *MockTodoRepository) GetTodos(todos *[]models.Todo, filter models.TodoFilter, now time.Time, userId int) error {
+	ret := _m.Called(todos, filter, now, userId)
+	return ret.Error(0)
+}
+
 func (_m *MockTodoRepository) GetTodoById(todo *models.Todo, id int, userId int) error {
 	ret := _m.Called(todo, id, userId)
 	return ret.Error(0)
 }
 
+func (_m *MockTodoRepository) FindTodoById(todo *models.Todo, id int) error {
+	ret := _m.Called(todo, id)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) GetAssignedTodos(todos *[]models.Todo, userId int) error {
+	ret := _m.Called(todos, userId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) GetSharedTodos(todos *[]models.Todo, userId int) error {
+	ret := _m.Called(todos, userId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) AssignTodo(todo *models.Todo, assigneeId *int) error {
+	ret := _m.Called(todo, assigneeId)
+	return ret.Error(0)
+}
+
 func (_m *MockTodoRepository) UpdateTodo(todo *models.Todo) error {
 	ret := _m.Called(todo)
 	return ret.Error(0)
@@ -43,12 +70,186 @@ func (_m *MockTodoRepository) DeleteTodo(todo *models.Todo) error {
 	return ret.Error(0)
 }
 
+func (_m *MockTodoRepository) CompleteTodo(todo *models.Todo, completeChecklistItems bool, nextTodo *models.Todo) error {
+	ret := _m.Called(todo, completeChecklistItems, nextTodo)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) ReopenTodo(todo *models.Todo) error {
+	ret := _m.Called(todo)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) ArchiveTodo(todo *models.Todo) error {
+	ret := _m.Called(todo)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) UnarchiveTodo(todo *models.Todo) error {
+	ret := _m.Called(todo)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) ArchiveTodosCompletedBefore(userId int, completedBefore time.Time) (int64, error) {
+	ret := _m.Called(userId, completedBefore)
+	return ret.Get(0).(int64), ret.Error(1)
+}
+
+func (_m *MockTodoRepository) GetTrashedTodos(todos *[]models.Todo, userId int) error {
+	ret := _m.Called(todos, userId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) GetTrashedTodoById(todo *models.Todo, id int, userId int) error {
+	ret := _m.Called(todo, id, userId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) RestoreTodo(todo *models.Todo) error {
+	ret := _m.Called(todo)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) PurgeTodo(todo *models.Todo) error {
+	ret := _m.Called(todo)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) PurgeTrashedTodos(userId int) (int64, error) {
+	ret := _m.Called(userId)
+	return ret.Get(0).(int64), ret.Error(1)
+}
+
+func (_m *MockTodoRepository) PurgeTodosDeletedBefore(deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(deletedBefore)
+	return ret.Get(0).(int64), ret.Error(1)
+}
+
+func (_m *MockTodoRepository) GetTodosLastModifiedAt(lastModifiedAt *time.Time, userId int) error {
+	ret := _m.Called(lastModifiedAt, userId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) GetPreviousTodoByPosition(todo *models.Todo, position string, excludeId int, userId int) error {
+	ret := _m.Called(todo, position, excludeId, userId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) GetNextTodoByPosition(todo *models.Todo, position string, excludeId int, userId int) error {
+	ret := _m.Called(todo, position, excludeId, userId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) MoveTodo(todo *models.Todo, position string) error {
+	ret := _m.Called(todo, position)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRepository) RebalanceTodoPositions(userId int) error {
+	ret := _m.Called(userId)
+	return ret.Error(0)
+}
+
+type MockTodoRevisionRepository struct {
+	mock.Mock
+}
+
+func (_m *MockTodoRevisionRepository) CreateTodoRevision(revision *models.TodoRevision) error {
+	ret := _m.Called(revision)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRevisionRepository) GetTodoRevisions(revisions *[]models.TodoRevision, todoId int) error {
+	ret := _m.Called(revisions, todoId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoRevisionRepository) GetTodoRevision(revision *models.TodoRevision, todoId int, revisionNumber int) error {
+	ret := _m.Called(revision, todoId, revisionNumber)
+	return ret.Error(0)
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (_m *MockUserRepository) CreateUser(user *models.User) error {
+	ret := _m.Called(user)
+	return ret.Error(0)
+}
+
+func (_m *MockUserRepository) FindUserByEmail(user *models.User, email string) error {
+	ret := _m.Called(user, email)
+	return ret.Error(0)
+}
+
+func (_m *MockUserRepository) FindUserById(id int) models.User {
+	ret := _m.Called(id)
+	return ret.Get(0).(models.User)
+}
+
+func (_m *MockUserRepository) GetUserById(user *models.User, id int) error {
+	ret := _m.Called(user, id)
+	return ret.Error(0)
+}
+
+type MockTodoShareRepository struct {
+	mock.Mock
+}
+
+func (_m *MockTodoShareRepository) SaveTodoShare(share *models.TodoShare) error {
+	ret := _m.Called(share)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoShareRepository) GetTodoShares(shares *[]models.TodoShare, todoId int) error {
+	ret := _m.Called(shares, todoId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoShareRepository) GetTodoShare(share *models.TodoShare, todoId int, userId int) error {
+	ret := _m.Called(share, todoId, userId)
+	return ret.Error(0)
+}
+
+func (_m *MockTodoShareRepository) DeleteTodoShare(share *models.TodoShare) error {
+	ret := _m.Called(share)
+	return ret.Error(0)
+}
+
+type MockNotificationRepository struct {
+	repositories.NotificationRepository
+	mock.Mock
+}
+
+func (_m *MockNotificationRepository) CreateNotification(notification *models.Notification) error {
+	ret := _m.Called(notification)
+	return ret.Error(0)
+}
+
+type MockUserSettingRepository struct {
+	repositories.UserSettingRepository
+	mock.Mock
+}
+
+type MockTransactionRepository struct {
+	repositories.TransactionRepository
+	mock.Mock
+}
+
 func (s *TodoServiceTestSuite) TestCreateTodo() {
 	// todoRepositoryをmock化
 	mockTodoRepository := new(MockTodoRepository)
-	mockTodoRepository.On("CreateTodo", &models.Todo{Title: "test title 1", Content: "test content 1", UserID: 1}).Return(nil)
+	mockTodoRepository.On("CreateTodo", &models.Todo{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusTodo, UserID: 1, Tags: []string{}}).Return(nil)
+	mockTodoRevisionRepository := new(MockTodoRevisionRepository)
+	mockTodoRevisionRepository.On("CreateTodoRevision", mock.Anything).Return(nil)
+	mockUserRepository := new(MockUserRepository)
+	mockTodoShareRepository := new(MockTodoShareRepository)
+	mockNotificationRepository := new(MockNotificationRepository)
+	mockUserSettingRepository := new(MockUserSettingRepository)
+	mockTransactionRepository := new(MockTransactionRepository)
 
-	ts := NewTodoService(mockTodoRepository)
+	ts := NewTodoService(mockTodoRepository, mockTodoRevisionRepository, mockUserRepository, mockTodoShareRepository, mockNotificationRepository, mockUserSettingRepository, mockTransactionRepository)
 	result := ts.CreateTodo(dto.CreateTodoRequest{Title: "test title 1", Content: "test content 1"}, 1)
 
 	assert.Equal(s.T(), nil, result.Error)
@@ -60,10 +261,17 @@ func (s *TodoServiceTestSuite) TestCreateTodo() {
 func (s *TodoServiceTestSuite) TestFetchTodosList() {
 	// todoRepositoryをmock化
 	mockTodoRepository := new(MockTodoRepository)
-	mockTodoRepository.On("GetAllTodos", &[]models.Todo{}, 1).Return(nil)
+	mockTodoRepository.On("GetTodos", &[]models.Todo{}, models.TodoFilter{Tags: []string{}}, mock.AnythingOfType("time.Time"), 1).Return(nil)
+	mockTodoRepository.On("GetTodosLastModifiedAt", &time.Time{}, 1).Return(nil)
+	mockTodoRevisionRepository := new(MockTodoRevisionRepository)
+	mockUserRepository := new(MockUserRepository)
+	mockTodoShareRepository := new(MockTodoShareRepository)
+	mockNotificationRepository := new(MockNotificationRepository)
+	mockUserSettingRepository := new(MockUserSettingRepository)
+	mockTransactionRepository := new(MockTransactionRepository)
 
-	ts := NewTodoService(mockTodoRepository)
-	result := ts.FetchTodosList(1)
+	ts := NewTodoService(mockTodoRepository, mockTodoRevisionRepository, mockUserRepository, mockTodoShareRepository, mockNotificationRepository, mockUserSettingRepository, mockTransactionRepository)
+	result := ts.FetchTodosList(models.TodoFilter{}, 1)
 
 	assert.Equal(s.T(), nil, result.Error)
 	assert.Equal(s.T(), "", result.ErrorType)
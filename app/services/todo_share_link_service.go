@@ -0,0 +1,119 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/utils"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// NOTE: トークンの元となるランダムなバイト数
+const todoShareLinkTokenBytes = 32
+
+// NOTE: 存在しない・期限切れのリンクは区別せず見つからないものとして扱う
+var errTodoShareLinkNotFound = errors.New("share link not found")
+
+var errTodoShareLinkPasswordMismatch = errors.New("password is required or incorrect")
+
+type TodoShareLinkService interface {
+	CreateTodoShareLink(id int, requestParams dto.CreateTodoShareLinkRequest, userId int) *dto.CreateTodoShareLinkResponse
+	FetchTodoShareLinks(id int, userId int) *dto.TodoShareLinksResponse
+	RevokeTodoShareLink(id int, linkId int, userId int) *dto.RevokeTodoShareLinkResponse
+	FetchSharedTodo(requestParams dto.FetchSharedTodoRequest) *dto.FetchSharedTodoResponse
+}
+
+type todoShareLinkService struct {
+	todoRepository          repositories.TodoRepository
+	todoShareLinkRepository repositories.TodoShareLinkRepository
+	authorizer              todoAuthorizer
+}
+
+func NewTodoShareLinkService(todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, todoShareLinkRepository repositories.TodoShareLinkRepository) TodoShareLinkService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	return &todoShareLinkService{todoRepository, todoShareLinkRepository, authorizer}
+}
+
+func (tsls *todoShareLinkService) CreateTodoShareLink(id int, requestParams dto.CreateTodoShareLinkRequest, userId int) *dto.CreateTodoShareLinkResponse {
+	todo := models.Todo{}
+	if errorType, err := tsls.authorizer.authorizeTodo(&todo, id, userId, todoPermissionManage); err != nil {
+		return &dto.CreateTodoShareLinkResponse{Link: models.TodoShareLink{}, Error: err, ErrorType: errorType}
+	}
+	if requestParams.ExpiresAt != nil && !requestParams.ExpiresAt.After(time.Now()) {
+		return &dto.CreateTodoShareLinkResponse{Link: models.TodoShareLink{}, Error: fmt.Errorf("expires_at must be in the future"), ErrorType: "badRequest"}
+	}
+
+	token, err := utils.GenerateToken(todoShareLinkTokenBytes)
+	if err != nil {
+		return &dto.CreateTodoShareLinkResponse{Link: models.TodoShareLink{}, Error: err, ErrorType: "internalServerError"}
+	}
+	link := models.TodoShareLink{TodoID: todo.ID, Token: token, ExpiresAt: requestParams.ExpiresAt, UserID: userId}
+	if requestParams.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(requestParams.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return &dto.CreateTodoShareLinkResponse{Link: models.TodoShareLink{}, Error: err, ErrorType: "internalServerError"}
+		}
+		link.PasswordHash = string(hash)
+		link.PasswordProtected = true
+	}
+
+	if err := tsls.todoShareLinkRepository.CreateTodoShareLink(&link); err != nil {
+		return &dto.CreateTodoShareLinkResponse{Link: link, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.CreateTodoShareLinkResponse{Link: link, Error: nil, ErrorType: ""}
+}
+
+func (tsls *todoShareLinkService) FetchTodoShareLinks(id int, userId int) *dto.TodoShareLinksResponse {
+	todo := models.Todo{}
+	if errorType, err := tsls.authorizer.authorizeTodo(&todo, id, userId, todoPermissionManage); err != nil {
+		return &dto.TodoShareLinksResponse{Links: []models.TodoShareLink{}, Error: err, ErrorType: errorType}
+	}
+
+	links := []models.TodoShareLink{}
+	if err := tsls.todoShareLinkRepository.GetTodoShareLinks(&links, todo.ID); err != nil {
+		return &dto.TodoShareLinksResponse{Links: []models.TodoShareLink{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TodoShareLinksResponse{Links: links, Error: nil, ErrorType: ""}
+}
+
+func (tsls *todoShareLinkService) RevokeTodoShareLink(id int, linkId int, userId int) *dto.RevokeTodoShareLinkResponse {
+	todo := models.Todo{}
+	if errorType, err := tsls.authorizer.authorizeTodo(&todo, id, userId, todoPermissionManage); err != nil {
+		return &dto.RevokeTodoShareLinkResponse{Error: err, ErrorType: errorType}
+	}
+
+	link := models.TodoShareLink{}
+	if err := tsls.todoShareLinkRepository.GetTodoShareLinkById(&link, linkId, todo.ID); err != nil {
+		return &dto.RevokeTodoShareLinkResponse{Error: err, ErrorType: "notFound"}
+	}
+	if err := tsls.todoShareLinkRepository.DeleteTodoShareLink(&link); err != nil {
+		return &dto.RevokeTodoShareLinkResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.RevokeTodoShareLinkResponse{Error: nil, ErrorType: ""}
+}
+
+// NOTE: 認証せずにリンクのトークンからTodoを閲覧する
+func (tsls *todoShareLinkService) FetchSharedTodo(requestParams dto.FetchSharedTodoRequest) *dto.FetchSharedTodoResponse {
+	link := models.TodoShareLink{}
+	if err := tsls.todoShareLinkRepository.GetTodoShareLinkByToken(&link, requestParams.Token); err != nil {
+		return &dto.FetchSharedTodoResponse{Todo: models.Todo{}, Error: errTodoShareLinkNotFound, ErrorType: "notFound"}
+	}
+	if link.IsExpired(time.Now()) {
+		return &dto.FetchSharedTodoResponse{Todo: models.Todo{}, Error: errTodoShareLinkNotFound, ErrorType: "notFound"}
+	}
+	if link.PasswordProtected {
+		if err := bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(requestParams.Password)); err != nil {
+			return &dto.FetchSharedTodoResponse{Todo: models.Todo{}, Error: errTodoShareLinkPasswordMismatch, ErrorType: "unauthorized"}
+		}
+	}
+
+	todo := models.Todo{}
+	if err := tsls.todoRepository.FindTodoById(&todo, link.TodoID); err != nil {
+		return &dto.FetchSharedTodoResponse{Todo: models.Todo{}, Error: errTodoShareLinkNotFound, ErrorType: "notFound"}
+	}
+	return &dto.FetchSharedTodoResponse{Todo: todo, ExpiresAt: link.ExpiresAt, Error: nil, ErrorType: ""}
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoShareLinkServiceSuite struct {
+	WithDbSuite
+}
+
+var testTodoShareLinkService TodoShareLinkService
+
+func (s *TestTodoShareLinkServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザ・Todoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo = models.Todo{Title: "test title 1", Content: "test content 1", UserID: user.ID}
+	if err := DbCon.Create(&todo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	todoShareLinkRepository := repositories.NewTodoShareLinkRepository(DbCon)
+	testTodoShareLinkService = NewTodoShareLinkService(todoRepository, todoShareRepository, todoShareLinkRepository)
+}
+
+func (s *TestTodoShareLinkServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoShareLinkServiceSuite) TestCreateTodoShareLink() {
+	result := testTodoShareLinkService.CreateTodoShareLink(todo.ID, dto.CreateTodoShareLinkRequest{}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.NotEqual(s.T(), "", result.Link.Token)
+	assert.False(s.T(), result.Link.PasswordProtected)
+	// NOTE: 認証なしでトークンから閲覧できること
+	shared := testTodoShareLinkService.FetchSharedTodo(dto.FetchSharedTodoRequest{Token: result.Link.Token})
+	assert.Nil(s.T(), shared.Error)
+	assert.Equal(s.T(), todo.ID, shared.Todo.ID)
+}
+
+func (s *TestTodoShareLinkServiceSuite) TestCreateTodoShareLink_Forbidden() {
+	viewer := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "viewer@example.com"}).(*models.User)
+	if err := DbCon.Create(&viewer).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	if err := DbCon.Create(&models.TodoShare{TodoID: todo.ID, UserID: viewer.ID, Role: models.TodoShareRoleViewer}).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share %v", err)
+	}
+
+	result := testTodoShareLinkService.CreateTodoShareLink(todo.ID, dto.CreateTodoShareLinkRequest{}, viewer.ID)
+
+	assert.Equal(s.T(), "forbidden", result.ErrorType)
+}
+
+func (s *TestTodoShareLinkServiceSuite) TestCreateTodoShareLink_PastExpiry() {
+	expiresAt := time.Now().Add(-time.Hour)
+
+	result := testTodoShareLinkService.CreateTodoShareLink(todo.ID, dto.CreateTodoShareLinkRequest{ExpiresAt: &expiresAt}, user.ID)
+
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestTodoShareLinkServiceSuite) TestFetchSharedTodo_Password() {
+	created := testTodoShareLinkService.CreateTodoShareLink(todo.ID, dto.CreateTodoShareLinkRequest{Password: "secret"}, user.ID)
+
+	missing := testTodoShareLinkService.FetchSharedTodo(dto.FetchSharedTodoRequest{Token: created.Link.Token})
+	wrong := testTodoShareLinkService.FetchSharedTodo(dto.FetchSharedTodoRequest{Token: created.Link.Token, Password: "wrong"})
+	correct := testTodoShareLinkService.FetchSharedTodo(dto.FetchSharedTodoRequest{Token: created.Link.Token, Password: "secret"})
+
+	assert.True(s.T(), created.Link.PasswordProtected)
+	assert.Equal(s.T(), "unauthorized", missing.ErrorType)
+	assert.Equal(s.T(), "unauthorized", wrong.ErrorType)
+	assert.Nil(s.T(), correct.Error)
+}
+
+func (s *TestTodoShareLinkServiceSuite) TestFetchSharedTodo_Expired() {
+	expiresAt := time.Now().Add(-time.Minute)
+	link := models.TodoShareLink{TodoID: todo.ID, Token: "test-token-1", ExpiresAt: &expiresAt, UserID: user.ID}
+	if err := DbCon.Create(&link).Error; err != nil {
+		s.T().Fatalf("failed to create test todo share link %v", err)
+	}
+
+	result := testTodoShareLinkService.FetchSharedTodo(dto.FetchSharedTodoRequest{Token: link.Token})
+
+	assert.Equal(s.T(), "notFound", result.ErrorType)
+}
+
+func (s *TestTodoShareLinkServiceSuite) TestRevokeTodoShareLink() {
+	created := testTodoShareLinkService.CreateTodoShareLink(todo.ID, dto.CreateTodoShareLinkRequest{}, user.ID)
+
+	result := testTodoShareLinkService.RevokeTodoShareLink(todo.ID, created.Link.ID, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	// NOTE: 取り消したリンクでは閲覧できないこと
+	shared := testTodoShareLinkService.FetchSharedTodo(dto.FetchSharedTodoRequest{Token: created.Link.Token})
+	assert.Equal(s.T(), "notFound", shared.ErrorType)
+	assert.Len(s.T(), testTodoShareLinkService.FetchTodoShareLinks(todo.ID, user.ID).Links, 0)
+}
+
+func TestTodoShareLinkService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoShareLinkServiceSuite))
+}
@@ -0,0 +1,179 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type TodoTemplateService interface {
+	CreateTodoTemplate(requestParams dto.CreateTodoTemplateRequest, userId int) *dto.CreateTodoTemplateResponse
+	FetchTodoTemplatesList(userId int) *dto.TodoTemplatesListResponse
+	FetchTodoTemplate(id int, userId int) *dto.FetchTodoTemplateResponse
+	UpdateTodoTemplate(id int, requestParams dto.UpdateTodoTemplateRequest, userId int) *dto.UpdateTodoTemplateResponse
+	DeleteTodoTemplate(id int, userId int) *dto.DeleteTodoTemplateResponse
+	InstantiateTodoTemplate(id int, requestParams dto.InstantiateTodoTemplateRequest, userId int) *dto.InstantiateTodoTemplateResponse
+	SaveTodoAsTemplate(todoId int, requestParams dto.SaveTodoAsTemplateRequest, userId int) *dto.SaveTodoAsTemplateResponse
+}
+
+type todoTemplateService struct {
+	todoTemplateRepository repositories.TodoTemplateRepository
+	todoService            TodoService
+	authorizer             todoAuthorizer
+}
+
+func NewTodoTemplateService(todoTemplateRepository repositories.TodoTemplateRepository, todoRepository repositories.TodoRepository, todoShareRepository repositories.TodoShareRepository, todoService TodoService) TodoTemplateService {
+	authorizer := todoAuthorizer{todoRepository, todoShareRepository}
+	return &todoTemplateService{todoTemplateRepository, todoService, authorizer}
+}
+
+func (tts *todoTemplateService) CreateTodoTemplate(requestParams dto.CreateTodoTemplateRequest, userId int) *dto.CreateTodoTemplateResponse {
+	template := models.TodoTemplate{
+		UserID:         userId,
+		Name:           requestParams.Name,
+		Title:          requestParams.Title,
+		Content:        requestParams.Content,
+		Priority:       requestParams.Priority,
+		ChecklistItems: requestParams.ChecklistItems,
+		Tags:           requestParams.Tags,
+		DueOffsetDays:  requestParams.DueOffsetDays,
+	}
+	if errorType, err := tts.validateTodoTemplate(&template); err != nil {
+		return &dto.CreateTodoTemplateResponse{Template: template, Error: err, ErrorType: errorType}
+	}
+
+	if err := tts.todoTemplateRepository.CreateTodoTemplate(&template); err != nil {
+		return &dto.CreateTodoTemplateResponse{Template: template, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.CreateTodoTemplateResponse{Template: template, Error: nil, ErrorType: ""}
+}
+
+func (tts *todoTemplateService) FetchTodoTemplatesList(userId int) *dto.TodoTemplatesListResponse {
+	templates := []models.TodoTemplate{}
+	if err := tts.todoTemplateRepository.GetTodoTemplates(&templates, userId); err != nil {
+		return &dto.TodoTemplatesListResponse{Templates: []models.TodoTemplate{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TodoTemplatesListResponse{Templates: templates, Error: nil, ErrorType: ""}
+}
+
+func (tts *todoTemplateService) FetchTodoTemplate(id int, userId int) *dto.FetchTodoTemplateResponse {
+	template := models.TodoTemplate{}
+	if err := tts.todoTemplateRepository.GetTodoTemplateById(&template, id, userId); err != nil {
+		return &dto.FetchTodoTemplateResponse{Template: models.TodoTemplate{}, Error: err, ErrorType: "notFound"}
+	}
+	return &dto.FetchTodoTemplateResponse{Template: template, Error: nil, ErrorType: ""}
+}
+
+func (tts *todoTemplateService) UpdateTodoTemplate(id int, requestParams dto.UpdateTodoTemplateRequest, userId int) *dto.UpdateTodoTemplateResponse {
+	template := models.TodoTemplate{}
+	if err := tts.todoTemplateRepository.GetTodoTemplateById(&template, id, userId); err != nil {
+		return &dto.UpdateTodoTemplateResponse{Template: models.TodoTemplate{}, Error: err, ErrorType: "notFound"}
+	}
+	template.Name = requestParams.Name
+	template.Title = requestParams.Title
+	template.Content = requestParams.Content
+	template.Priority = requestParams.Priority
+	template.ChecklistItems = requestParams.ChecklistItems
+	template.Tags = requestParams.Tags
+	template.DueOffsetDays = requestParams.DueOffsetDays
+	if errorType, err := tts.validateTodoTemplate(&template); err != nil {
+		return &dto.UpdateTodoTemplateResponse{Template: template, Error: err, ErrorType: errorType}
+	}
+
+	if err := tts.todoTemplateRepository.UpdateTodoTemplate(&template); err != nil {
+		return &dto.UpdateTodoTemplateResponse{Template: template, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.UpdateTodoTemplateResponse{Template: template, Error: nil, ErrorType: ""}
+}
+
+func (tts *todoTemplateService) DeleteTodoTemplate(id int, userId int) *dto.DeleteTodoTemplateResponse {
+	template := models.TodoTemplate{}
+	if err := tts.todoTemplateRepository.GetTodoTemplateById(&template, id, userId); err != nil {
+		return &dto.DeleteTodoTemplateResponse{Error: err, ErrorType: "notFound"}
+	}
+
+	if err := tts.todoTemplateRepository.DeleteTodoTemplate(&template); err != nil {
+		return &dto.DeleteTodoTemplateResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.DeleteTodoTemplateResponse{Error: nil, ErrorType: ""}
+}
+
+// NOTE: 変数を置き換えた上で通常のTodoと同じ手順で作成する(期日は作成時点からの日数で決める)
+func (tts *todoTemplateService) InstantiateTodoTemplate(id int, requestParams dto.InstantiateTodoTemplateRequest, userId int) *dto.InstantiateTodoTemplateResponse {
+	template := models.TodoTemplate{}
+	if err := tts.todoTemplateRepository.GetTodoTemplateById(&template, id, userId); err != nil {
+		return &dto.InstantiateTodoTemplateResponse{Todo: models.Todo{}, Error: err, ErrorType: "notFound"}
+	}
+	missing := []string{}
+	for _, variable := range template.Variables() {
+		if _, ok := requestParams.Variables[variable]; !ok {
+			missing = append(missing, variable)
+		}
+	}
+	if len(missing) > 0 {
+		return &dto.InstantiateTodoTemplateResponse{Todo: models.Todo{}, Error: fmt.Errorf("missing template variables: %s", strings.Join(missing, ", ")), ErrorType: "badRequest"}
+	}
+
+	createParams := dto.CreateTodoRequest{
+		Title:          models.ExpandTemplateVariables(template.Title, requestParams.Variables),
+		Content:        models.ExpandTemplateVariables(template.Content, requestParams.Variables),
+		Priority:       template.Priority,
+		Tags:           template.Tags,
+		ChecklistItems: []string{},
+	}
+	for _, item := range template.ChecklistItems {
+		createParams.ChecklistItems = append(createParams.ChecklistItems, models.ExpandTemplateVariables(item, requestParams.Variables))
+	}
+	if template.DueOffsetDays != nil {
+		dueAt := time.Now().AddDate(0, 0, *template.DueOffsetDays)
+		createParams.DueAt = &dueAt
+	}
+
+	result := tts.todoService.CreateTodo(createParams, userId)
+	return &dto.InstantiateTodoTemplateResponse{Todo: result.Todo, Error: result.Error, ErrorType: result.ErrorType}
+}
+
+func (tts *todoTemplateService) SaveTodoAsTemplate(todoId int, requestParams dto.SaveTodoAsTemplateRequest, userId int) *dto.SaveTodoAsTemplateResponse {
+	todo := models.Todo{}
+	if errorType, err := tts.authorizer.authorizeTodo(&todo, todoId, userId, todoPermissionRead); err != nil {
+		return &dto.SaveTodoAsTemplateResponse{Template: models.TodoTemplate{}, Error: err, ErrorType: errorType}
+	}
+
+	template := models.NewTodoTemplate(todo)
+	template.UserID = userId
+	template.Name = requestParams.Name
+	if template.Name == "" {
+		template.Name = todo.Title
+	}
+	if errorType, err := tts.validateTodoTemplate(&template); err != nil {
+		return &dto.SaveTodoAsTemplateResponse{Template: template, Error: err, ErrorType: errorType}
+	}
+
+	if err := tts.todoTemplateRepository.CreateTodoTemplate(&template); err != nil {
+		return &dto.SaveTodoAsTemplateResponse{Template: template, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.SaveTodoAsTemplateResponse{Template: template, Error: nil, ErrorType: ""}
+}
+
+// NOTE: タグ名を正規化した上で雛形を検証する
+func (tts *todoTemplateService) validateTodoTemplate(template *models.TodoTemplate) (string, error) {
+	tags, err := normalizeTagNames(template.Tags)
+	if err != nil {
+		return "badRequest", err
+	}
+	template.Tags = tags
+	if template.ChecklistItems == nil {
+		template.ChecklistItems = []string{}
+	}
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	if validationErrors := validate.Struct(template); validationErrors != nil {
+		return "validationError", validationErrors
+	}
+	return "", nil
+}
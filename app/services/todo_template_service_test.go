@@ -0,0 +1,148 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoTemplateServiceSuite struct {
+	WithDbSuite
+}
+
+var testTodoTemplateService TodoTemplateService
+
+func (s *TestTodoTemplateServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	todoTemplateRepository := repositories.NewTodoTemplateRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	todoRevisionRepository := repositories.NewTodoRevisionRepository(DbCon)
+	userRepository := repositories.NewUserRepository(DbCon)
+	todoShareRepository := repositories.NewTodoShareRepository(DbCon)
+	notificationRepository := repositories.NewNotificationRepository(DbCon)
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+	transactionRepository := repositories.NewTransactionRepository(DbCon)
+	todoService := NewTodoService(todoRepository, todoRevisionRepository, userRepository, todoShareRepository, notificationRepository, userSettingRepository, transactionRepository)
+	testTodoTemplateService = NewTodoTemplateService(todoTemplateRepository, todoRepository, todoShareRepository, todoService)
+}
+
+func (s *TestTodoTemplateServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoTemplateServiceSuite) createOnboardingTemplate() models.TodoTemplate {
+	dueOffsetDays := 7
+	result := testTodoTemplateService.CreateTodoTemplate(dto.CreateTodoTemplateRequest{
+		Name:           "onboarding",
+		Title:          "Onboard {{name}}",
+		Content:        "Welcome {{ name }} to {{team}}",
+		Priority:       models.TodoPriorityHigh,
+		ChecklistItems: []string{"Create account for {{name}}", "Add to {{team}} channel"},
+		Tags:           []string{"#HR"},
+		DueOffsetDays:  &dueOffsetDays,
+	}, user.ID)
+	if result.Error != nil {
+		s.T().Fatalf("failed to create test template %v", result.Error)
+	}
+	return result.Template
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoTemplate() {
+	template := s.createOnboardingTemplate()
+
+	assert.NotEqual(s.T(), 0, template.ID)
+	assert.Equal(s.T(), []string{"hr"}, template.Tags)
+	assert.Equal(s.T(), []string{"name", "team"}, template.Variables())
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoTemplate_ValidationError() {
+	result := testTodoTemplateService.CreateTodoTemplate(dto.CreateTodoTemplateRequest{Name: "empty", Title: "", ChecklistItems: []string{""}}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestInstantiateTodoTemplate() {
+	template := s.createOnboardingTemplate()
+
+	result := testTodoTemplateService.InstantiateTodoTemplate(template.ID, dto.InstantiateTodoTemplateRequest{Variables: map[string]string{"name": "Alice", "team": "Platform"}}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "Onboard Alice", result.Todo.Title)
+	assert.Equal(s.T(), "Welcome Alice to Platform", result.Todo.Content)
+	assert.Equal(s.T(), models.TodoPriorityHigh, result.Todo.Priority)
+	assert.Equal(s.T(), 7, int(time.Until(*result.Todo.DueAt).Hours()/24+0.5))
+	// NOTE: チェックリスト・タグも作成されていることを確認
+	createdTodo := models.Todo{}
+	if err := repositories.NewTodoRepository(DbCon).GetTodoById(&createdTodo, result.Todo.ID, user.ID); err != nil {
+		s.T().Fatalf("failed to fetch todo %v", err)
+	}
+	assert.Equal(s.T(), []string{"hr"}, createdTodo.Tags)
+	assert.Len(s.T(), createdTodo.ChecklistItems, 2)
+	assert.Equal(s.T(), "Create account for Alice", createdTodo.ChecklistItems[0].Title)
+	assert.Equal(s.T(), "Add to Platform channel", createdTodo.ChecklistItems[1].Title)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestInstantiateTodoTemplate_MissingVariables() {
+	template := s.createOnboardingTemplate()
+
+	result := testTodoTemplateService.InstantiateTodoTemplate(template.ID, dto.InstantiateTodoTemplateRequest{Variables: map[string]string{"name": "Alice"}}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+	assert.Contains(s.T(), result.Error.Error(), "team")
+}
+
+func (s *TestTodoTemplateServiceSuite) TestSaveTodoAsTemplate() {
+	createdAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.Local)
+	dueAt := time.Date(2024, 5, 4, 18, 0, 0, 0, time.Local)
+	testTodo := models.Todo{Title: "Onboard Bob", Content: "test content", UserID: user.ID, DueAt: &dueAt, CreatedAt: createdAt,
+		ChecklistItems: []models.ChecklistItem{{Title: "Create account", Position: 1}},
+		TodoTags:       []models.TodoTag{{Name: "hr"}},
+	}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	result := testTodoTemplateService.SaveTodoAsTemplate(testTodo.ID, dto.SaveTodoAsTemplateRequest{}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), "Onboard Bob", result.Template.Name)
+	assert.Equal(s.T(), []string{"Create account"}, result.Template.ChecklistItems)
+	assert.Equal(s.T(), []string{"hr"}, result.Template.Tags)
+	assert.Equal(s.T(), 3, *result.Template.DueOffsetDays)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestSaveTodoAsTemplate_NotFound() {
+	other := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "other@example.com"}).(*models.User)
+	if err := DbCon.Create(&other).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo := models.Todo{Title: "private", Content: "test content", UserID: other.ID}
+	if err := DbCon.Create(&testTodo).Error; err != nil {
+		s.T().Fatalf("failed to create test todo %v", err)
+	}
+
+	result := testTodoTemplateService.SaveTodoAsTemplate(testTodo.ID, dto.SaveTodoAsTemplateRequest{}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "notFound", result.ErrorType)
+}
+
+func TestTodoTemplateService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoTemplateServiceSuite))
+}
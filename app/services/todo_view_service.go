@@ -0,0 +1,129 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// NOTE: 保存したビュー・組み込みの一覧のいずれにも該当しない場合のエラー
+var errTodoViewNotFound = errors.New("view not found")
+
+type TodoViewService interface {
+	CreateTodoView(requestParams dto.CreateTodoViewRequest, userId int) *dto.CreateTodoViewResponse
+	FetchTodoViewsList(userId int) *dto.TodoViewsListResponse
+	FetchTodoView(id int, userId int) *dto.FetchTodoViewResponse
+	UpdateTodoView(id int, requestParams dto.UpdateTodoViewRequest, userId int) *dto.UpdateTodoViewResponse
+	DeleteTodoView(id int, userId int) *dto.DeleteTodoViewResponse
+	FetchTodoViewTodosList(key string, userId int) *dto.TodoViewTodosListResponse
+}
+
+type todoViewService struct {
+	todoViewRepository repositories.TodoViewRepository
+	todoRepository     repositories.TodoRepository
+}
+
+func NewTodoViewService(todoViewRepository repositories.TodoViewRepository, todoRepository repositories.TodoRepository) TodoViewService {
+	return &todoViewService{todoViewRepository, todoRepository}
+}
+
+func (tvs *todoViewService) CreateTodoView(requestParams dto.CreateTodoViewRequest, userId int) *dto.CreateTodoViewResponse {
+	view := models.TodoView{}
+	view.UserID = userId
+	if errorType, err := tvs.setTodoViewFields(&view, requestParams.Name, requestParams.Filter); err != nil {
+		return &dto.CreateTodoViewResponse{View: view, Error: err, ErrorType: errorType}
+	}
+
+	if err := tvs.todoViewRepository.CreateTodoView(&view); err != nil {
+		return &dto.CreateTodoViewResponse{View: view, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.CreateTodoViewResponse{View: view, Error: nil, ErrorType: ""}
+}
+
+func (tvs *todoViewService) FetchTodoViewsList(userId int) *dto.TodoViewsListResponse {
+	views := []models.TodoView{}
+	if err := tvs.todoViewRepository.GetTodoViews(&views, userId); err != nil {
+		return &dto.TodoViewsListResponse{Views: []models.TodoView{}, SmartLists: []models.SmartList{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TodoViewsListResponse{Views: views, SmartLists: models.SmartLists(), Error: nil, ErrorType: ""}
+}
+
+func (tvs *todoViewService) FetchTodoView(id int, userId int) *dto.FetchTodoViewResponse {
+	view := models.TodoView{}
+	if err := tvs.todoViewRepository.GetTodoViewById(&view, id, userId); err != nil {
+		return &dto.FetchTodoViewResponse{View: models.TodoView{}, Error: err, ErrorType: "notFound"}
+	}
+	return &dto.FetchTodoViewResponse{View: view, Error: nil, ErrorType: ""}
+}
+
+func (tvs *todoViewService) UpdateTodoView(id int, requestParams dto.UpdateTodoViewRequest, userId int) *dto.UpdateTodoViewResponse {
+	view := models.TodoView{}
+	if err := tvs.todoViewRepository.GetTodoViewById(&view, id, userId); err != nil {
+		return &dto.UpdateTodoViewResponse{View: models.TodoView{}, Error: err, ErrorType: "notFound"}
+	}
+	if errorType, err := tvs.setTodoViewFields(&view, requestParams.Name, requestParams.Filter); err != nil {
+		return &dto.UpdateTodoViewResponse{View: view, Error: err, ErrorType: errorType}
+	}
+
+	if err := tvs.todoViewRepository.UpdateTodoView(&view); err != nil {
+		return &dto.UpdateTodoViewResponse{View: view, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.UpdateTodoViewResponse{View: view, Error: nil, ErrorType: ""}
+}
+
+func (tvs *todoViewService) DeleteTodoView(id int, userId int) *dto.DeleteTodoViewResponse {
+	view := models.TodoView{}
+	if err := tvs.todoViewRepository.GetTodoViewById(&view, id, userId); err != nil {
+		return &dto.DeleteTodoViewResponse{Error: err, ErrorType: "notFound"}
+	}
+
+	if err := tvs.todoViewRepository.DeleteTodoView(&view); err != nil {
+		return &dto.DeleteTodoViewResponse{Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.DeleteTodoViewResponse{Error: nil, ErrorType: ""}
+}
+
+// NOTE: keyには保存したビューのIDまたは組み込みの一覧のキー(today・overdue・upcoming)を指定する
+func (tvs *todoViewService) FetchTodoViewTodosList(key string, userId int) *dto.TodoViewTodosListResponse {
+	filter := models.TodoFilter{}
+	if smartList, ok := models.FindSmartList(key); ok {
+		filter = smartList.Filter
+	} else {
+		id, err := strconv.Atoi(key)
+		if err != nil {
+			return &dto.TodoViewTodosListResponse{Todos: []models.Todo{}, Error: errTodoViewNotFound, ErrorType: "notFound"}
+		}
+		view := models.TodoView{}
+		if err := tvs.todoViewRepository.GetTodoViewById(&view, id, userId); err != nil {
+			return &dto.TodoViewTodosListResponse{Todos: []models.Todo{}, Error: err, ErrorType: "notFound"}
+		}
+		filter = view.Filter
+	}
+
+	// NOTE: 一覧と同じ絞り込みで取得する
+	todos := []models.Todo{}
+	if err := tvs.todoRepository.GetTodos(&todos, filter, time.Now(), userId); err != nil {
+		return &dto.TodoViewTodosListResponse{Todos: []models.Todo{}, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.TodoViewTodosListResponse{Todos: todos, Error: nil, ErrorType: ""}
+}
+
+func (tvs *todoViewService) setTodoViewFields(view *models.TodoView, name string, filter models.TodoFilter) (string, error) {
+	filter, errorType, err := normalizeTodoFilter(filter)
+	if err != nil {
+		return errorType, err
+	}
+	view.Name = name
+	view.Filter = filter
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	if validationErrors := validate.Struct(view); validationErrors != nil {
+		return "validationError", validationErrors
+	}
+	return "", nil
+}
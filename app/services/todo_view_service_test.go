@@ -0,0 +1,113 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTodoViewServiceSuite struct {
+	WithDbSuite
+}
+
+var testTodoViewService TodoViewService
+
+func (s *TestTodoViewServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	todoViewRepository := repositories.NewTodoViewRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	testTodoViewService = NewTodoViewService(todoViewRepository, todoRepository)
+}
+
+func (s *TestTodoViewServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestTodoViewServiceSuite) TestCreateTodoView() {
+	result := testTodoViewService.CreateTodoView(dto.CreateTodoViewRequest{Name: "work", Filter: models.TodoFilter{Tags: []string{"#Work", "work"}}}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), []string{"work"}, result.View.Filter.Tags)
+}
+
+func (s *TestTodoViewServiceSuite) TestCreateTodoView_ValidationError() {
+	result := testTodoViewService.CreateTodoView(dto.CreateTodoViewRequest{Name: "", Filter: models.TodoFilter{Priorities: []string{"urgent"}}}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
+func (s *TestTodoViewServiceSuite) TestCreateTodoView_InvalidDueRange() {
+	from := 3
+	to := 1
+	result := testTodoViewService.CreateTodoView(dto.CreateTodoViewRequest{Name: "invalid", Filter: models.TodoFilter{DueFromDays: &from, DueToDays: &to}}, user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "badRequest", result.ErrorType)
+}
+
+func (s *TestTodoViewServiceSuite) TestFetchTodoViewTodosList() {
+	dueAt := time.Now().AddDate(0, 0, 3)
+	testTodos := []models.Todo{
+		{Title: "high", Content: "test content", UserID: user.ID, DueAt: &dueAt, Priority: models.TodoPriorityHigh},
+		{Title: "low", Content: "test content", UserID: user.ID, DueAt: &dueAt, Priority: models.TodoPriorityLow},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	view := testTodoViewService.CreateTodoView(dto.CreateTodoViewRequest{Name: "high priority", Filter: models.TodoFilter{Priorities: []string{models.TodoPriorityHigh}}}, user.ID).View
+
+	result := testTodoViewService.FetchTodoViewTodosList(strconv.Itoa(view.ID), user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Len(s.T(), result.Todos, 1)
+	assert.Equal(s.T(), "high", result.Todos[0].Title)
+}
+
+func (s *TestTodoViewServiceSuite) TestFetchTodoViewTodosList_SmartList() {
+	overdueAt := time.Now().Add(-time.Hour)
+	upcomingAt := time.Now().AddDate(0, 0, 2)
+	testTodos := []models.Todo{
+		{Title: "overdue", Content: "test content", UserID: user.ID, DueAt: &overdueAt},
+		{Title: "upcoming", Content: "test content", UserID: user.ID, DueAt: &upcomingAt},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	overdue := testTodoViewService.FetchTodoViewTodosList(models.SmartListOverdue, user.ID)
+	upcoming := testTodoViewService.FetchTodoViewTodosList(models.SmartListUpcoming, user.ID)
+
+	assert.Nil(s.T(), overdue.Error)
+	assert.Len(s.T(), overdue.Todos, 1)
+	assert.Equal(s.T(), "overdue", overdue.Todos[0].Title)
+	assert.Nil(s.T(), upcoming.Error)
+	assert.Len(s.T(), upcoming.Todos, 1)
+	assert.Equal(s.T(), "upcoming", upcoming.Todos[0].Title)
+}
+
+func (s *TestTodoViewServiceSuite) TestFetchTodoViewTodosList_NotFound() {
+	result := testTodoViewService.FetchTodoViewTodosList("someday", user.ID)
+
+	assert.NotNil(s.T(), result.Error)
+	assert.Equal(s.T(), "notFound", result.ErrorType)
+}
+
+func TestTodoViewService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestTodoViewServiceSuite))
+}
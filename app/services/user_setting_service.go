@@ -0,0 +1,101 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"errors"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+type UserSettingService interface {
+	FetchUserSetting(userId int) *dto.FetchUserSettingResponse
+	UpdateUserSetting(requestParams dto.UpdateUserSettingRequest, userId int) *dto.UpdateUserSettingResponse
+	AutoArchiveTodos() *dto.AutoArchiveTodosResponse
+}
+
+type userSettingService struct {
+	userSettingRepository repositories.UserSettingRepository
+	todoRepository        repositories.TodoRepository
+}
+
+func NewUserSettingService(userSettingRepository repositories.UserSettingRepository, todoRepository repositories.TodoRepository) UserSettingService {
+	return &userSettingService{userSettingRepository, todoRepository}
+}
+
+func (uss *userSettingService) FetchUserSetting(userId int) *dto.FetchUserSettingResponse {
+	setting, err := uss.findUserSetting(userId)
+	if err != nil {
+		return &dto.FetchUserSettingResponse{Setting: setting, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.FetchUserSettingResponse{Setting: setting, Error: nil, ErrorType: ""}
+}
+
+func (uss *userSettingService) UpdateUserSetting(requestParams dto.UpdateUserSettingRequest, userId int) *dto.UpdateUserSettingResponse {
+	setting, err := uss.findUserSetting(userId)
+	if err != nil {
+		return &dto.UpdateUserSettingResponse{Setting: setting, Error: err, ErrorType: "internalServerError"}
+	}
+	if requestParams.AutoArchiveDays.Set {
+		setting.AutoArchiveDays = requestParams.AutoArchiveDays.Value
+	}
+	if requestParams.WebhookURL != nil {
+		setting.WebhookURL = *requestParams.WebhookURL
+	}
+	if requestParams.MutedNotificationTypes != nil {
+		setting.MutedNotificationTypes = *requestParams.MutedNotificationTypes
+	}
+	if setting.MutedNotificationTypes == nil {
+		setting.MutedNotificationTypes = []string{}
+	}
+	// NOTE: バリデーションチェック
+	validate := validator.New()
+	validationErrors := validate.Struct(setting)
+	if validationErrors != nil {
+		return &dto.UpdateUserSettingResponse{Setting: setting, Error: validationErrors, ErrorType: "validationError"}
+	}
+
+	if err := uss.userSettingRepository.SaveUserSetting(&setting); err != nil {
+		return &dto.UpdateUserSettingResponse{Setting: setting, Error: err, ErrorType: "internalServerError"}
+	}
+	return &dto.UpdateUserSettingResponse{Setting: setting, Error: nil, ErrorType: ""}
+}
+
+// NOTE: 自動アーカイブを設定しているユーザごとに、完了から設定日数が経過したTodoをアーカイブする
+func (uss *userSettingService) AutoArchiveTodos() *dto.AutoArchiveTodosResponse {
+	settings := []models.UserSetting{}
+	if err := uss.userSettingRepository.GetAutoArchiveUserSettings(&settings); err != nil {
+		return &dto.AutoArchiveTodosResponse{ArchivedCount: 0, Error: err, ErrorType: "internalServerError"}
+	}
+
+	now := time.Now()
+	archivedCount := int64(0)
+	for _, setting := range settings {
+		completedBefore := now.AddDate(0, 0, -*setting.AutoArchiveDays)
+		count, err := uss.todoRepository.ArchiveTodosCompletedBefore(setting.UserID, completedBefore)
+		if err != nil {
+			return &dto.AutoArchiveTodosResponse{ArchivedCount: archivedCount, Error: err, ErrorType: "internalServerError"}
+		}
+		archivedCount += count
+	}
+	return &dto.AutoArchiveTodosResponse{ArchivedCount: archivedCount, Error: nil, ErrorType: ""}
+}
+
+// NOTE: 設定が未作成の場合は既定値を返す
+func (uss *userSettingService) findUserSetting(userId int) (models.UserSetting, error) {
+	setting := models.UserSetting{}
+	err := uss.userSettingRepository.GetUserSetting(&setting, userId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.UserSetting{UserID: userId, MutedNotificationTypes: []string{}}, nil
+	}
+	if err != nil {
+		return models.UserSetting{}, err
+	}
+	if setting.MutedNotificationTypes == nil {
+		setting.MutedNotificationTypes = []string{}
+	}
+	return setting, nil
+}
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"app/dto"
+	"app/models"
+	"app/repositories"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestUserSettingServiceSuite struct {
+	WithDbSuite
+}
+
+var testUserSettingService UserSettingService
+
+func (s *TestUserSettingServiceSuite) SetupTest() {
+	s.SetDbCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := DbCon.Create(&user).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	userSettingRepository := repositories.NewUserSettingRepository(DbCon)
+	todoRepository := repositories.NewTodoRepository(DbCon)
+	testUserSettingService = NewUserSettingService(userSettingRepository, todoRepository)
+}
+
+func (s *TestUserSettingServiceSuite) TearDownTest() {
+	s.CloseDb()
+}
+
+func (s *TestUserSettingServiceSuite) TestFetchUserSetting_Default() {
+	result := testUserSettingService.FetchUserSetting(user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), user.ID, result.Setting.UserID)
+	assert.Nil(s.T(), result.Setting.AutoArchiveDays)
+	assert.Equal(s.T(), []string{}, result.Setting.MutedNotificationTypes)
+}
+
+func (s *TestUserSettingServiceSuite) TestUpdateUserSetting() {
+	autoArchiveDays := 14
+	result := testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{AutoArchiveDays: dto.NullableInt{Value: &autoArchiveDays, Set: true}}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	fetched := testUserSettingService.FetchUserSetting(user.ID)
+	assert.Equal(s.T(), result.Setting.ID, fetched.Setting.ID)
+	assert.Equal(s.T(), 14, *fetched.Setting.AutoArchiveDays)
+}
+
+func (s *TestUserSettingServiceSuite) TestUpdateUserSetting_Partial() {
+	autoArchiveDays := 14
+	webhookURL := "https://example.com/hooks/reminder"
+	testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{
+		AutoArchiveDays:        dto.NullableInt{Value: &autoArchiveDays, Set: true},
+		WebhookURL:             &webhookURL,
+		MutedNotificationTypes: &[]string{models.NotificationTypeCommented},
+	}, user.ID)
+
+	// NOTE: 省略した項目は更新されないこと
+	updatedDays := 30
+	result := testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{AutoArchiveDays: dto.NullableInt{Value: &updatedDays, Set: true}}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	fetched := testUserSettingService.FetchUserSetting(user.ID)
+	assert.Equal(s.T(), 30, *fetched.Setting.AutoArchiveDays)
+	assert.Equal(s.T(), webhookURL, fetched.Setting.WebhookURL)
+	assert.Equal(s.T(), []string{models.NotificationTypeCommented}, fetched.Setting.MutedNotificationTypes)
+
+	// NOTE: nullを指定した場合は自動アーカイブを無効にすること
+	disabled := testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{AutoArchiveDays: dto.NullableInt{Value: nil, Set: true}}, user.ID)
+	assert.Nil(s.T(), disabled.Error)
+	assert.Nil(s.T(), testUserSettingService.FetchUserSetting(user.ID).Setting.AutoArchiveDays)
+	assert.Equal(s.T(), webhookURL, testUserSettingService.FetchUserSetting(user.ID).Setting.WebhookURL)
+}
+
+func (s *TestUserSettingServiceSuite) TestUpdateUserSetting_ValidationError() {
+	autoArchiveDays := 0
+	result := testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{AutoArchiveDays: dto.NullableInt{Value: &autoArchiveDays, Set: true}}, user.ID)
+
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+	// NOTE: httpsでないwebhookのURLは保存できないこと
+	webhookURL := "http://example.com/hooks/reminder"
+	result = testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{WebhookURL: &webhookURL}, user.ID)
+	assert.Equal(s.T(), "validationError", result.ErrorType)
+}
+
+func (s *TestUserSettingServiceSuite) TestUpdateUserSetting_MutedNotificationTypes() {
+	result := testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{MutedNotificationTypes: &[]string{models.NotificationTypeCommented}}, user.ID)
+
+	assert.Nil(s.T(), result.Error)
+	fetched := testUserSettingService.FetchUserSetting(user.ID)
+	assert.False(s.T(), fetched.Setting.NotificationEnabled(models.NotificationTypeCommented))
+	assert.True(s.T(), fetched.Setting.NotificationEnabled(models.NotificationTypeAssigned))
+
+	invalid := testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{MutedNotificationTypes: &[]string{"unknown"}}, user.ID)
+	assert.Equal(s.T(), "validationError", invalid.ErrorType)
+}
+
+func (s *TestUserSettingServiceSuite) TestAutoArchiveTodos() {
+	otherUser := models.User{Name: "other", Email: "other@example.com", Password: "password"}
+	if err := DbCon.Create(&otherUser).Error; err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	autoArchiveDays := 7
+	testUserSettingService.UpdateUserSetting(dto.UpdateUserSettingRequest{AutoArchiveDays: dto.NullableInt{Value: &autoArchiveDays, Set: true}}, user.ID)
+	completedAt := time.Now().AddDate(0, 0, -8)
+	testTodos := []models.Todo{
+		{Title: "test title 1", Content: "test content 1", Status: models.TodoStatusDone, CompletedAt: &completedAt, UserID: user.ID},
+		{Title: "test title 2", Content: "test content 2", Status: models.TodoStatusDone, CompletedAt: &completedAt, UserID: otherUser.ID},
+	}
+	if err := DbCon.Create(&testTodos).Error; err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	result := testUserSettingService.AutoArchiveTodos()
+
+	// NOTE: 自動アーカイブを設定していないユーザのTodoはアーカイブされないこと
+	assert.Nil(s.T(), result.Error)
+	assert.Equal(s.T(), int64(1), result.ArchivedCount)
+	fetchedTodo := models.Todo{}
+	DbCon.First(&fetchedTodo, testTodos[1].ID)
+	assert.Nil(s.T(), fetchedTodo.ArchivedAt)
+}
+
+func TestUserSettingService(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestUserSettingServiceSuite))
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type localStorage struct {
+	baseDir string
+}
+
+func NewLocalStorage(baseDir string) Storage {
+	return &localStorage{baseDir}
+}
+
+func (ls *localStorage) Put(key string, body io.Reader, size int64, contentType string) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// NOTE: 書き込み途中のファイルを読まれないよう一時ファイルに書き込んでから置き換える
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := io.Copy(file, body); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (ls *localStorage) Get(key string) (io.ReadCloser, error) {
+	path, err := ls.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (ls *localStorage) Delete(key string) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// NOTE: キーが保存先のディレクトリの外を指さないことを確認する
+func (ls *localStorage) path(key string) (string, error) {
+	baseDir := filepath.Clean(ls.baseDir)
+	path := filepath.Join(baseDir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, baseDir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key: %s", key)
+	}
+	return path, nil
+}
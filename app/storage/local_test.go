@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestLocalStorageSuite struct {
+	suite.Suite
+	storage Storage
+}
+
+func (s *TestLocalStorageSuite) SetupTest() {
+	s.storage = NewLocalStorage(s.T().TempDir())
+}
+
+func (s *TestLocalStorageSuite) TestPutAndGet() {
+	err := s.storage.Put("todos/1/test.txt", strings.NewReader("test content"), 12, "text/plain")
+
+	assert.Nil(s.T(), err)
+	body, getErr := s.storage.Get("todos/1/test.txt")
+	assert.Nil(s.T(), getErr)
+	content, _ := io.ReadAll(body)
+	body.Close()
+	assert.Equal(s.T(), "test content", string(content))
+}
+
+func (s *TestLocalStorageSuite) TestDelete() {
+	s.storage.Put("todos/1/test.txt", strings.NewReader("test content"), 12, "text/plain")
+
+	err := s.storage.Delete("todos/1/test.txt")
+
+	assert.Nil(s.T(), err)
+	_, getErr := s.storage.Get("todos/1/test.txt")
+	assert.ErrorIs(s.T(), getErr, ErrObjectNotFound)
+	// NOTE: 存在しないファイルの削除はエラーにしないこと
+	assert.Nil(s.T(), s.storage.Delete("todos/1/test.txt"))
+}
+
+func (s *TestLocalStorageSuite) TestInvalidKey() {
+	err := s.storage.Put("../outside.txt", strings.NewReader("test content"), 12, "text/plain")
+
+	assert.NotNil(s.T(), err)
+}
+
+func TestLocalStorage(t *testing.T) {
+	suite.Run(t, new(TestLocalStorageSuite))
+}
@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// NOTE: ストリーミングで送信するため、本文のハッシュは署名に含めない
+const s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type s3Storage struct {
+	config S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+// NOTE: S3互換のAPI(パス形式のURL)で保存する。MinIOなどのローカル環境でも利用できる
+func NewS3Storage(config S3Config, client *http.Client) Storage {
+	return &s3Storage{config, client, time.Now}
+}
+
+func (ss *s3Storage) Put(key string, body io.Reader, size int64, contentType string) error {
+	req, err := http.NewRequest(http.MethodPut, ss.objectURL(key), body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := ss.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return nil
+}
+
+func (ss *s3Storage) Get(key string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, ss.objectURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ss.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+func (ss *s3Storage) Delete(key string) error {
+	req, err := http.NewRequest(http.MethodDelete, ss.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := ss.do(req)
+	if err == ErrObjectNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	return nil
+}
+
+func (ss *s3Storage) objectURL(key string) string {
+	return strings.TrimRight(ss.config.Endpoint, "/") + "/" + s3EscapePath(ss.config.Bucket+"/"+key)
+}
+
+func (ss *s3Storage) do(req *http.Request) (*http.Response, error) {
+	ss.sign(req, s3UnsignedPayload, ss.now())
+	res, err := ss.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return nil, ErrObjectNotFound
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		res.Body.Close()
+		return nil, fmt.Errorf("s3 %s %s failed with status %d: %s", req.Method, req.URL.Path, res.StatusCode, message)
+	}
+	return res, nil
+}
+
+// NOTE: AWS Signature Version 4でリクエストに署名する(設定済みのヘッダは全て署名対象とする)
+func (ss *s3Storage) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	canonicalHeaders := ""
+	for _, name := range names {
+		canonicalHeaders += name + ":" + headers[name] + "\n"
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + ss.config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+ss.config.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, ss.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+ss.config.AccessKeyID+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// NOTE: 署名の仕様に合わせ、英数字と-_.~/以外をエンコードする
+func s3EscapePath(path string) string {
+	var b strings.Builder
+	for _, c := range []byte(path) {
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || strings.IndexByte("-_.~/", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
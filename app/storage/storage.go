@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"app/config"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// NOTE: 指定したキーのファイルが存在しない場合のエラー
+var ErrObjectNotFound = errors.New("object not found")
+
+// NOTE: 添付ファイルの保存先(ローカルファイルシステム・S3互換ストレージを切り替えられる)
+type Storage interface {
+	Put(key string, body io.Reader, size int64, contentType string) error
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
+
+func Init() Storage {
+	switch config.Config.StorageDriver {
+	case "s3":
+		return NewS3Storage(S3Config{
+			Endpoint:        config.Config.S3Endpoint,
+			Region:          config.Config.S3Region,
+			Bucket:          config.Config.S3Bucket,
+			AccessKeyID:     config.Config.S3AccessKeyID,
+			SecretAccessKey: config.Config.S3SecretAccessKey,
+		}, http.DefaultClient)
+	default:
+		return NewLocalStorage(config.Config.StorageLocalDir)
+	}
+}
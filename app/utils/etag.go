@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func FormatETag(version int) string {
+	return "\"" + strconv.Itoa(version) + "\""
+}
+
+// NOTE: If-Matchヘッダの値がETagに一致するか判定する(未指定と*は常に一致とみなす)
+func MatchETag(ifMatch string, etag string) bool {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+
+	for _, tag := range strings.Split(ifMatch, ",") {
+		// NOTE: If-Matchは強い比較のため、弱いETag(W/)は一致させない
+		if strings.TrimSpace(tag) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// NOTE: If-None-Match/If-Modified-Sinceからクライアントのキャッシュが有効か判定する(If-None-Matchを優先する)
+func IsNotModified(ifNoneMatch string, ifModifiedSince string, etag string, lastModifiedAt time.Time) bool {
+	ifNoneMatch = strings.TrimSpace(ifNoneMatch)
+	if ifNoneMatch != "" {
+		if ifNoneMatch == "*" {
+			return true
+		}
+		// NOTE: If-None-Matchは弱い比較のため、W/を除いて比較する
+		for _, tag := range strings.Split(ifNoneMatch, ",") {
+			if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince == "" || lastModifiedAt.IsZero() {
+		return false
+	}
+	modifiedSince, err := http.ParseTime(ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	// NOTE: Last-Modifiedは秒単位のため、切り捨てて比較する
+	return !lastModifiedAt.Truncate(time.Second).After(modifiedSince)
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestETagSuite struct {
+	suite.Suite
+}
+
+func (s *TestETagSuite) TestFormatETag() {
+	assert.Equal(s.T(), "\"3\"", FormatETag(3))
+}
+
+func (s *TestETagSuite) TestMatchETag() {
+	assert.True(s.T(), MatchETag("", "\"3\""))
+	assert.True(s.T(), MatchETag("*", "\"3\""))
+	assert.True(s.T(), MatchETag("\"3\"", "\"3\""))
+	assert.True(s.T(), MatchETag("\"2\", \"3\"", "\"3\""))
+	assert.False(s.T(), MatchETag("\"2\"", "\"3\""))
+	// NOTE: 弱いETagは一致とみなさない
+	assert.False(s.T(), MatchETag("W/\"3\"", "\"3\""))
+}
+
+func (s *TestETagSuite) TestIsNotModified() {
+	lastModifiedAt := time.Date(2025, 1, 10, 9, 0, 0, 500, time.UTC)
+
+	assert.True(s.T(), IsNotModified("\"3\"", "", "\"3\"", lastModifiedAt))
+	assert.True(s.T(), IsNotModified("W/\"abc\"", "", "W/\"abc\"", lastModifiedAt))
+	assert.False(s.T(), IsNotModified("\"2\"", "", "\"3\"", lastModifiedAt))
+	assert.True(s.T(), IsNotModified("", "Fri, 10 Jan 2025 09:00:00 GMT", "\"3\"", lastModifiedAt))
+	assert.False(s.T(), IsNotModified("", "Fri, 10 Jan 2025 08:59:59 GMT", "\"3\"", lastModifiedAt))
+	// NOTE: If-None-Matchが指定されている場合はIf-Modified-Sinceを無視する
+	assert.False(s.T(), IsNotModified("\"2\"", "Fri, 10 Jan 2025 09:00:00 GMT", "\"3\"", lastModifiedAt))
+	assert.False(s.T(), IsNotModified("", "invalid", "\"3\"", lastModifiedAt))
+}
+
+func TestETag(t *testing.T) {
+	suite.Run(t, new(TestETagSuite))
+}
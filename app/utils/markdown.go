@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+var markdown = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// NOTE: 生のHTMLはgoldmarkが出力しないが、リンク等も含めて利用者の入力として扱うためサニタイズする
+var markdownHTMLPolicy = newMarkdownHTMLPolicy()
+
+var markdownTextPolicy = bluemonday.StrictPolicy()
+
+var blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+
+func newMarkdownHTMLPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	// NOTE: タスクリストのチェックボックスのみ許可する
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	return policy
+}
+
+// NOTE: GitHub Flavored MarkdownとしてHTMLに変換し、スクリプト等を除去する
+func RenderMarkdown(source string) string {
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(source), &buf); err != nil {
+		// NOTE: bytes.Bufferへの書き込みは失敗しないため、変換できない場合はエスケープした原文を返す
+		return html.EscapeString(source)
+	}
+	return markdownHTMLPolicy.Sanitize(buf.String())
+}
+
+// NOTE: Markdownの記法を取り除いたプレーンテキストに変換する
+func MarkdownToPlainText(source string) string {
+	text := html.UnescapeString(markdownTextPolicy.Sanitize(RenderMarkdown(source)))
+	return strings.TrimSpace(blankLinesPattern.ReplaceAllString(text, "\n\n"))
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestMarkdownSuite struct {
+	suite.Suite
+}
+
+func (s *TestMarkdownSuite) TestRenderMarkdown() {
+	rendered := RenderMarkdown("# Title\n\n- [x] **done** item\n- [ ] ~~old~~ item\n\n[docs](https://example.com)")
+
+	assert.Contains(s.T(), rendered, "<h1>Title</h1>")
+	assert.Contains(s.T(), rendered, "<strong>done</strong>")
+	assert.Contains(s.T(), rendered, "<del>old</del>")
+	assert.Contains(s.T(), rendered, `<input checked="" disabled="" type="checkbox"`)
+	assert.Contains(s.T(), rendered, `<a href="https://example.com" rel="nofollow">docs</a>`)
+}
+
+func (s *TestMarkdownSuite) TestRenderMarkdown_Sanitize() {
+	sources := []string{
+		"<script>alert(1)</script>",
+		"<img src=x onerror=alert(1)>",
+		"[click](javascript:alert(1))",
+		"<a href=\"javascript:alert(1)\">click</a>",
+	}
+	for _, source := range sources {
+		rendered := RenderMarkdown(source)
+		assert.NotContains(s.T(), rendered, "<script", source)
+		assert.NotContains(s.T(), rendered, "onerror", source)
+		assert.NotContains(s.T(), rendered, "javascript:", source)
+	}
+}
+
+func (s *TestMarkdownSuite) TestMarkdownToPlainText() {
+	text := MarkdownToPlainText("# Title\n\nSome **bold** & [linked](https://example.com) text\n\n<script>alert(1)</script>")
+
+	assert.Equal(s.T(), "Title\nSome bold & linked text", text)
+}
+
+func TestMarkdown(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestMarkdownSuite))
+}
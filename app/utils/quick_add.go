@@ -0,0 +1,281 @@
+package utils
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// NOTE: 日付のみ指定された場合は当日中を期日とする
+	quickAddDefaultHour   = 23
+	quickAddDefaultMinute = 59
+	// NOTE: 「今夜」「tonight」で時刻が指定されなかった場合の時刻
+	quickAddTonightHour = 20
+)
+
+// NOTE: 1行の入力から読み取った内容(読み取った部分はタイトルから取り除く)
+type QuickAdd struct {
+	Title    string
+	DueAt    *time.Time
+	Tags     []string
+	Priority string
+}
+
+// NOTE: 日付・時刻を取り除いた位置の目印
+const quickAddRemoved = "\x00"
+
+type quickAddDate struct {
+	date    time.Time
+	tonight bool
+}
+
+type quickAddRule[T any] struct {
+	pattern *regexp.Regexp
+	parse   func(match []string, today time.Time) (T, bool)
+}
+
+var quickAddTagPattern = regexp.MustCompile(`(?:^|\s)[#＃]([\p{L}\p{N}_-]+)`)
+
+var quickAddPriorityPattern = regexp.MustCompile(`(?i)(?:^|\s)[!！](high|medium|low|h|m|l|1|2|3|高|中|低)(?:\s|$)`)
+
+var quickAddPriorities = map[string]string{
+	"high": "high", "h": "high", "1": "high", "高": "high",
+	"medium": "medium", "m": "medium", "2": "medium", "中": "medium",
+	"low": "low", "l": "low", "3": "low", "低": "low",
+}
+
+var quickAddEnglishWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+var quickAddJapaneseWeekdays = map[string]time.Weekday{
+	"日": time.Sunday, "月": time.Monday, "火": time.Tuesday, "水": time.Wednesday, "木": time.Thursday, "金": time.Friday, "土": time.Saturday,
+}
+
+// NOTE: 先に一致したものを優先する(日付・時刻はそれぞれ1つだけ読み取る)
+var quickAddDateRules = []quickAddRule[quickAddDate]{
+	{regexp.MustCompile(`(?i)\b(?:(?:on|by|due)\s+)?(\d{4})-(\d{1,2})-(\d{1,2})\b`), func(match []string, today time.Time) (quickAddDate, bool) {
+		year, _ := strconv.Atoi(match[1])
+		return quickAddCalendarDate(today, year, match[2], match[3])
+	}},
+	{regexp.MustCompile(`(?i)\b(?:(?:on|by|due)\s+)?(\d{1,2})/(\d{1,2})\b`), func(match []string, today time.Time) (quickAddDate, bool) {
+		return quickAddCalendarDate(today, 0, match[1], match[2])
+	}},
+	{regexp.MustCompile(`(\d{1,2})月(\d{1,2})日(?:までに|まで|に|の)?`), func(match []string, today time.Time) (quickAddDate, bool) {
+		return quickAddCalendarDate(today, 0, match[1], match[2])
+	}},
+	{regexp.MustCompile(`(?i)\b(?:(?:on|by|due)\s+)?(day after tomorrow|today|tonight|tomorrow|tmrw?)\b`), func(match []string, today time.Time) (quickAddDate, bool) {
+		switch strings.ToLower(match[1]) {
+		case "today":
+			return quickAddDate{date: today}, true
+		case "tonight":
+			return quickAddDate{date: today, tonight: true}, true
+		case "day after tomorrow":
+			return quickAddDate{date: today.AddDate(0, 0, 2)}, true
+		}
+		return quickAddDate{date: today.AddDate(0, 0, 1)}, true
+	}},
+	{regexp.MustCompile(`(今日|本日|今夜|今晩|明日|あした|明後日|あさって)(?:までに|まで|に|の)?`), func(match []string, today time.Time) (quickAddDate, bool) {
+		switch match[1] {
+		case "今日", "本日":
+			return quickAddDate{date: today}, true
+		case "今夜", "今晩":
+			return quickAddDate{date: today, tonight: true}, true
+		case "明後日", "あさって":
+			return quickAddDate{date: today.AddDate(0, 0, 2)}, true
+		}
+		return quickAddDate{date: today.AddDate(0, 0, 1)}, true
+	}},
+	{regexp.MustCompile(`(?i)\bin\s+(\d{1,3})\s+(days?|weeks?)\b`), func(match []string, today time.Time) (quickAddDate, bool) {
+		return quickAddRelativeDate(today, match[1], strings.HasPrefix(strings.ToLower(match[2]), "week"))
+	}},
+	{regexp.MustCompile(`(\d{1,3})(日|週間)後(?:までに|まで|に|の)?`), func(match []string, today time.Time) (quickAddDate, bool) {
+		return quickAddRelativeDate(today, match[1], match[2] == "週間")
+	}},
+	{regexp.MustCompile(`(?i)\b(?:(?:on|by|due)\s+)?(next\s+)?(monday|mon|tuesday|tues|tue|wednesday|wed|thursday|thurs|thur|thu|friday|fri|saturday|sat|sunday|sun)\b`), func(match []string, today time.Time) (quickAddDate, bool) {
+		return quickAddDate{date: quickAddWeekday(today, quickAddEnglishWeekdays[strings.ToLower(match[2])], match[1] != "")}, true
+	}},
+	{regexp.MustCompile(`(来週の?)?([月火水木金土日])曜日?(?:までに|まで|に|の)?`), func(match []string, today time.Time) (quickAddDate, bool) {
+		return quickAddDate{date: quickAddWeekday(today, quickAddJapaneseWeekdays[match[2]], match[1] != "")}, true
+	}},
+	{regexp.MustCompile(`(?i)\bnext\s+week\b|来週(?:までに|まで|に|の)?`), func(match []string, today time.Time) (quickAddDate, bool) {
+		return quickAddDate{date: today.AddDate(0, 0, 7)}, true
+	}},
+}
+
+// NOTE: 時刻は時・分(0時からの経過分)で返す
+var quickAddTimeRules = []quickAddRule[int]{
+	{regexp.MustCompile(`(?i)\b(?:at\s+)?(\d{1,2})(?::(\d{2}))?\s*(am|pm)\b`), func(match []string, today time.Time) (int, bool) {
+		hour, _ := strconv.Atoi(match[1])
+		if hour < 1 || hour > 12 {
+			return 0, false
+		}
+		hour %= 12
+		if strings.ToLower(match[3]) == "pm" {
+			hour += 12
+		}
+		return quickAddClock(hour, match[2])
+	}},
+	{regexp.MustCompile(`(?i)\b(?:at\s+)?(\d{1,2}):(\d{2})\b`), func(match []string, today time.Time) (int, bool) {
+		hour, _ := strconv.Atoi(match[1])
+		return quickAddClock(hour, match[2])
+	}},
+	{regexp.MustCompile(`(?i)\b(?:at\s+)?(noon|midnight)\b`), func(match []string, today time.Time) (int, bool) {
+		if strings.ToLower(match[1]) == "noon" {
+			return 12 * 60, true
+		}
+		return 0, true
+	}},
+	{regexp.MustCompile(`(午前|午後)?(\d{1,2})時(?:(\d{1,2})分|(半))?(?:までに|まで|に)?`), func(match []string, today time.Time) (int, bool) {
+		hour, _ := strconv.Atoi(match[2])
+		if match[1] != "" && hour > 12 {
+			return 0, false
+		}
+		if match[1] == "午後" && hour < 12 {
+			hour += 12
+		}
+		if match[4] != "" {
+			return quickAddClock(hour, "30")
+		}
+		return quickAddClock(hour, match[3])
+	}},
+}
+
+// NOTE: タグ(#tag)・優先度(!high)・英語/日本語の日付と時刻を読み取り、残りをタイトルとする
+func ParseQuickAdd(text string, now time.Time) QuickAdd {
+	result := QuickAdd{Tags: []string{}}
+	rest := " " + strings.ReplaceAll(text, quickAddRemoved, "") + " "
+
+	for _, match := range quickAddTagPattern.FindAllStringSubmatch(rest, -1) {
+		result.Tags = append(result.Tags, strings.ToLower(match[1]))
+	}
+	rest = quickAddTagPattern.ReplaceAllString(rest, " ")
+	if match := quickAddPriorityPattern.FindStringSubmatch(rest); match != nil {
+		result.Priority = quickAddPriorities[strings.ToLower(match[1])]
+		rest = strings.Replace(rest, match[0], " ", 1)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	rest, date, hasDate := applyQuickAddRules(rest, today, quickAddDateRules)
+	rest, minutes, hasTime := applyQuickAddRules(rest, today, quickAddTimeRules)
+
+	if hasDate || hasTime {
+		dueAt := today.Add(time.Duration(minutes) * time.Minute)
+		switch {
+		case hasDate && hasTime:
+			dueAt = date.date.Add(time.Duration(minutes) * time.Minute)
+		case hasDate && date.tonight:
+			dueAt = date.date.Add(quickAddTonightHour * time.Hour)
+		case hasDate:
+			dueAt = date.date.Add(quickAddDefaultHour*time.Hour + quickAddDefaultMinute*time.Minute)
+		case !dueAt.After(now):
+			// NOTE: 時刻のみで既に過ぎている場合は翌日とする
+			dueAt = dueAt.AddDate(0, 0, 1)
+		}
+		result.DueAt = &dueAt
+	}
+
+	result.Title = quickAddTitle(rest)
+	return result
+}
+
+// NOTE: 最初に一致し、値として解釈できた部分を取り除く
+func applyQuickAddRules[T any](text string, today time.Time, rules []quickAddRule[T]) (string, T, bool) {
+	var zero T
+	for _, rule := range rules {
+		for _, index := range rule.pattern.FindAllStringSubmatchIndex(text, -1) {
+			match := make([]string, len(index)/2)
+			for i := range match {
+				if index[2*i] >= 0 {
+					match[i] = text[index[2*i]:index[2*i+1]]
+				}
+			}
+			if value, ok := rule.parse(match, today); ok {
+				return text[:index[0]] + quickAddRemoved + text[index[1]:], value, true
+			}
+		}
+	}
+	return text, zero, false
+}
+
+// NOTE: 年を省略した場合は今日以降で最も近い日付とする
+func quickAddCalendarDate(today time.Time, year int, monthText string, dayText string) (quickAddDate, bool) {
+	month, _ := strconv.Atoi(monthText)
+	day, _ := strconv.Atoi(dayText)
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return quickAddDate{}, false
+	}
+	omitYear := year == 0
+	if omitYear {
+		year = today.Year()
+	}
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, today.Location())
+	if date.Day() != day {
+		return quickAddDate{}, false
+	}
+	if omitYear && date.Before(today) {
+		date = date.AddDate(1, 0, 0)
+	}
+	return quickAddDate{date: date}, true
+}
+
+func quickAddRelativeDate(today time.Time, countText string, weeks bool) (quickAddDate, bool) {
+	count, _ := strconv.Atoi(countText)
+	if weeks {
+		count *= 7
+	}
+	return quickAddDate{date: today.AddDate(0, 0, count)}, true
+}
+
+// NOTE: 翌日以降で最も近い曜日とし、nextWeekの場合は来週(月曜始まり)のその曜日とする
+func quickAddWeekday(today time.Time, weekday time.Weekday, nextWeek bool) time.Time {
+	if nextWeek {
+		daysToNextMonday := 7 - (int(today.Weekday())+6)%7
+		return today.AddDate(0, 0, daysToNextMonday+(int(weekday)+6)%7)
+	}
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return today.AddDate(0, 0, days)
+}
+
+func quickAddClock(hour int, minuteText string) (int, bool) {
+	minute := 0
+	if minuteText != "" {
+		minute, _ = strconv.Atoi(minuteText)
+	}
+	if hour > 23 || minute > 59 {
+		return 0, false
+	}
+	return hour*60 + minute, true
+}
+
+// NOTE: 取り除いた位置の前後がどちらも日本語などの非ASCII文字の場合は空白を挟まずにつなげる
+func quickAddTitle(text string) string {
+	title := ""
+	for _, part := range strings.Split(text, quickAddRemoved) {
+		part = strings.Join(strings.Fields(part), " ")
+		if part == "" {
+			continue
+		}
+		if title != "" {
+			last, _ := utf8.DecodeLastRuneInString(title)
+			first, _ := utf8.DecodeRuneInString(part)
+			if last < utf8.RuneSelf || first < utf8.RuneSelf {
+				title += " "
+			}
+		}
+		title += part
+	}
+	return title
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestQuickAddSuite struct {
+	suite.Suite
+}
+
+// NOTE: 2024-05-15(水) 10:00を基準とする
+var quickAddNow = time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+
+func quickAddAt(year int, month time.Month, day int, hour int, minute int) *time.Time {
+	t := time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	return &t
+}
+
+func (s *TestQuickAddSuite) TestParseQuickAdd() {
+	result := ParseQuickAdd("Pay rent tomorrow 9am #home !high", quickAddNow)
+
+	assert.Equal(s.T(), "Pay rent", result.Title)
+	assert.Equal(s.T(), quickAddAt(2024, 5, 16, 9, 0), result.DueAt)
+	assert.Equal(s.T(), []string{"home"}, result.Tags)
+	assert.Equal(s.T(), "high", result.Priority)
+}
+
+func (s *TestQuickAddSuite) TestParseQuickAdd_Japanese() {
+	result := ParseQuickAdd("明日の午後3時半に家賃を払う #家計 !高", quickAddNow)
+
+	assert.Equal(s.T(), "家賃を払う", result.Title)
+	assert.Equal(s.T(), quickAddAt(2024, 5, 16, 15, 30), result.DueAt)
+	assert.Equal(s.T(), []string{"家計"}, result.Tags)
+	assert.Equal(s.T(), "high", result.Priority)
+}
+
+func (s *TestQuickAddSuite) TestParseQuickAdd_Dates() {
+	cases := []struct {
+		text  string
+		title string
+		dueAt *time.Time
+	}{
+		{"Submit report today", "Submit report", quickAddAt(2024, 5, 15, 23, 59)},
+		{"Call mom tonight", "Call mom", quickAddAt(2024, 5, 15, 20, 0)},
+		{"Dentist on 2024-06-03 at 14:30", "Dentist", quickAddAt(2024, 6, 3, 14, 30)},
+		{"Renew passport 3/1", "Renew passport", quickAddAt(2025, 3, 1, 23, 59)},
+		{"Review PR in 2 weeks", "Review PR", quickAddAt(2024, 5, 29, 23, 59)},
+		{"Standup friday at noon", "Standup", quickAddAt(2024, 5, 17, 12, 0)},
+		{"Team lunch wednesday", "Team lunch", quickAddAt(2024, 5, 22, 23, 59)},
+		{"Retro next monday 5pm", "Retro", quickAddAt(2024, 5, 20, 17, 0)},
+		{"Water plants 8am", "Water plants", quickAddAt(2024, 5, 16, 8, 0)},
+		{"資料を明後日までに提出", "資料を提出", quickAddAt(2024, 5, 17, 23, 59)},
+		{"来週の金曜日に打ち合わせ", "打ち合わせ", quickAddAt(2024, 5, 24, 23, 59)},
+		{"6月1日10時に健康診断", "健康診断", quickAddAt(2024, 6, 1, 10, 0)},
+		{"3日後に振り返り", "振り返り", quickAddAt(2024, 5, 18, 23, 59)},
+	}
+	for _, c := range cases {
+		result := ParseQuickAdd(c.text, quickAddNow)
+		assert.Equal(s.T(), c.title, result.Title, c.text)
+		assert.Equal(s.T(), c.dueAt, result.DueAt, c.text)
+	}
+}
+
+func (s *TestQuickAddSuite) TestParseQuickAdd_NoDate() {
+	result := ParseQuickAdd("Buy milk #Groceries #errands !low", quickAddNow)
+
+	assert.Equal(s.T(), "Buy milk", result.Title)
+	assert.Nil(s.T(), result.DueAt)
+	assert.Equal(s.T(), []string{"groceries", "errands"}, result.Tags)
+	assert.Equal(s.T(), "low", result.Priority)
+}
+
+func (s *TestQuickAddSuite) TestParseQuickAdd_InvalidValuesAreKept() {
+	result := ParseQuickAdd("Read chapter 13/40 at 25:00 !urgent", quickAddNow)
+
+	assert.Equal(s.T(), "Read chapter 13/40 at 25:00 !urgent", result.Title)
+	assert.Nil(s.T(), result.DueAt)
+	assert.Equal(s.T(), "", result.Priority)
+}
+
+func TestQuickAdd(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestQuickAddSuite))
+}
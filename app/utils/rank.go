@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: 大文字小文字を区別しない照合順序でも順序が変わらないよう、数字と小文字のみを使う
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// NOTE: lowerとupperの間に入る順位を返す(空文字は上限・下限なしを表す)
+func RankBetween(lower string, upper string) (string, error) {
+	if upper != "" && lower >= upper {
+		return "", fmt.Errorf("rank %q must be less than %q", lower, upper)
+	}
+	if !isValidRank(lower) || !isValidRank(upper) {
+		return "", fmt.Errorf("invalid rank %q, %q", lower, upper)
+	}
+	if upper == "" && lower != "" {
+		return rankAfter(lower), nil
+	}
+	return rankMidpoint(lower, upper), nil
+}
+
+// NOTE: n件分の順位を等間隔に振り直す
+func EvenRanks(n int) []string {
+	width := 1
+	capacity := len(rankDigits)
+	for capacity < (n+1)*len(rankDigits) {
+		width++
+		capacity *= len(rankDigits)
+	}
+
+	step := capacity / (n + 1)
+	ranks := make([]string, n)
+	for i := range ranks {
+		value := (i + 1) * step
+		rank := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			rank[j] = rankDigits[value%len(rankDigits)]
+			value /= len(rankDigits)
+		}
+		// NOTE: 末尾の0を除いても順序は変わらない
+		ranks[i] = strings.TrimRight(string(rank), "0")
+	}
+	return ranks
+}
+
+// NOTE: 末尾が0の順位はそれより前に挿入できなくなるため不正とする
+func isValidRank(rank string) bool {
+	for _, r := range rank {
+		if !strings.ContainsRune(rankDigits, r) {
+			return false
+		}
+	}
+	return !strings.HasSuffix(rank, "0")
+}
+
+// NOTE: 末尾への追加が続いても桁が増えにくいよう、中間ではなく最小限大きい順位を返す
+func rankAfter(lower string) string {
+	for i := 0; i < len(lower); i++ {
+		digit := strings.IndexByte(rankDigits, lower[i])
+		if digit < len(rankDigits)-1 {
+			return lower[:i] + string(rankDigits[digit+1])
+		}
+	}
+	return lower + string(rankDigits[1])
+}
+
+func rankMidpoint(lower string, upper string) string {
+	if upper != "" {
+		// NOTE: 共通の接頭辞はそのまま残し、残りの部分の中間を求める
+		n := 0
+		for n < len(upper) && rankDigitAt(lower, n) == upper[n] {
+			n++
+		}
+		if n > 0 {
+			return upper[:n] + rankMidpoint(rankSuffix(lower, n), upper[n:])
+		}
+	}
+
+	lowerDigit := 0
+	if lower != "" {
+		lowerDigit = strings.IndexByte(rankDigits, lower[0])
+	}
+	upperDigit := len(rankDigits)
+	if upper != "" {
+		upperDigit = strings.IndexByte(rankDigits, upper[0])
+	}
+	if upperDigit-lowerDigit > 1 {
+		return string(rankDigits[(lowerDigit+upperDigit+1)/2])
+	}
+	// NOTE: 先頭の桁が隣り合う場合は桁を増やす
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+	return string(rankDigits[lowerDigit]) + rankMidpoint(rankSuffix(lower, 1), "")
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
+
+func rankSuffix(rank string, i int) string {
+	if i < len(rank) {
+		return rank[i:]
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestRankSuite struct {
+	suite.Suite
+}
+
+func (s *TestRankSuite) TestRankBetween() {
+	first, err := RankBetween("", "")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "i", first)
+
+	next, err := RankBetween("i", "")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "j", next)
+
+	cases := [][2]string{{"zz", ""}, {"az", ""}, {"", "i"}, {"i", ""}, {"a", "b"}, {"a", "a1"}, {"az", "b"}, {"a1", "a2"}, {"", "01"}}
+	for _, c := range cases {
+		rank, err := RankBetween(c[0], c[1])
+		assert.Nil(s.T(), err, c)
+		assert.Less(s.T(), c[0], rank, c)
+		if c[1] != "" {
+			assert.Less(s.T(), rank, c[1], c)
+		}
+	}
+}
+
+func (s *TestRankSuite) TestRankBetween_Repeated() {
+	// NOTE: 同じ位置に繰り返し挿入しても順序が保たれること
+	lower, upper := "a", "b"
+	for i := 0; i < 100; i++ {
+		rank, err := RankBetween(lower, upper)
+		assert.Nil(s.T(), err)
+		assert.True(s.T(), lower < rank && rank < upper)
+		upper = rank
+	}
+}
+
+func (s *TestRankSuite) TestRankBetween_Append() {
+	// NOTE: 末尾への追加を繰り返しても順位が長くなりすぎないこと
+	rank := ""
+	for i := 0; i < 1000; i++ {
+		next, err := RankBetween(rank, "")
+		assert.Nil(s.T(), err)
+		assert.Less(s.T(), rank, next)
+		rank = next
+	}
+	assert.LessOrEqual(s.T(), len(rank), 30)
+}
+
+func (s *TestRankSuite) TestRankBetween_Invalid() {
+	_, sameErr := RankBetween("b", "b")
+	_, reversedErr := RankBetween("c", "b")
+	_, trailingZeroErr := RankBetween("a0", "")
+	_, invalidDigitErr := RankBetween("A", "")
+
+	assert.NotNil(s.T(), sameErr)
+	assert.NotNil(s.T(), reversedErr)
+	assert.NotNil(s.T(), trailingZeroErr)
+	assert.NotNil(s.T(), invalidDigitErr)
+}
+
+func (s *TestRankSuite) TestEvenRanks() {
+	for _, n := range []int{1, 10, 100, 2000} {
+		ranks := EvenRanks(n)
+		assert.Len(s.T(), ranks, n)
+		assert.True(s.T(), sort.StringsAreSorted(ranks))
+		for i, rank := range ranks {
+			assert.True(s.T(), isValidRank(rank) && rank != "", rank)
+			if i > 0 {
+				assert.NotEqual(s.T(), ranks[i-1], rank)
+			}
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	suite.Run(t, new(TestRankSuite))
+}
@@ -0,0 +1,259 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	RecurrenceFreqDaily   = "DAILY"
+	RecurrenceFreqWeekly  = "WEEKLY"
+	RecurrenceFreqMonthly = "MONTHLY"
+
+	// NOTE: 不正なルールで無限ループしないための上限
+	maxRecurrencePeriods = 10000
+)
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type RecurrenceByDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// NOTE: RFC 5545のRRULEのうちFREQ(DAILY/WEEKLY/MONTHLY), INTERVAL, BYDAY, UNTIL, COUNTに対応する
+type RecurrenceRule struct {
+	Freq     string
+	Interval int
+	ByDay    []RecurrenceByDay
+	Until    *time.Time
+	Count    int
+}
+
+func ParseRecurrenceRule(rule string) (*RecurrenceRule, error) {
+	r := &RecurrenceRule{Interval: 1}
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		if part == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid rrule part: %s", part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			freq := strings.ToUpper(value)
+			if freq != RecurrenceFreqDaily && freq != RecurrenceFreqWeekly && freq != RecurrenceFreqMonthly {
+				return nil, fmt.Errorf("unsupported FREQ: %s", value)
+			}
+			r.Freq = freq
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL: %s", value)
+			}
+			r.Interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				byDay, err := parseRecurrenceByDay(strings.ToUpper(day))
+				if err != nil {
+					return nil, err
+				}
+				r.ByDay = append(r.ByDay, byDay)
+			}
+		case "UNTIL":
+			until, err := parseRecurrenceUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			r.Until = &until
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid COUNT: %s", value)
+			}
+			r.Count = count
+		default:
+			return nil, fmt.Errorf("unsupported rrule part: %s", key)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if r.Until != nil && r.Count > 0 {
+		return nil, fmt.Errorf("UNTIL and COUNT must not both be specified")
+	}
+	for _, byDay := range r.ByDay {
+		if byDay.Ordinal != 0 && r.Freq != RecurrenceFreqMonthly {
+			return nil, fmt.Errorf("BYDAY with ordinal is only supported for MONTHLY")
+		}
+	}
+	return r, nil
+}
+
+// NOTE: dtstartから数えて、afterより後の発生日時を最大n件返す
+func (r *RecurrenceRule) Occurrences(dtstart time.Time, after time.Time, n int) []time.Time {
+	occurrences := []time.Time{}
+	if n <= 0 {
+		return occurrences
+	}
+
+	generated := 0
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, candidate := range r.candidates(dtstart, period) {
+			if candidate.Before(dtstart) {
+				continue
+			}
+			if r.Until != nil && candidate.After(*r.Until) {
+				return occurrences
+			}
+			generated++
+			if r.Count > 0 && generated > r.Count {
+				return occurrences
+			}
+			if candidate.After(after) {
+				occurrences = append(occurrences, candidate)
+				if len(occurrences) == n {
+					return occurrences
+				}
+			}
+		}
+	}
+	return occurrences
+}
+
+// NOTE: afterより後の直近の発生日時を返す(無ければnil)
+func (r *RecurrenceRule) Next(dtstart time.Time, after time.Time) *time.Time {
+	occurrences := r.Occurrences(dtstart, after, 1)
+	if len(occurrences) == 0 {
+		return nil
+	}
+	return &occurrences[0]
+}
+
+func (r *RecurrenceRule) candidates(dtstart time.Time, period int) []time.Time {
+	switch r.Freq {
+	case RecurrenceFreqDaily:
+		day := dtstart.AddDate(0, 0, period*r.Interval)
+		if len(r.ByDay) > 0 && !r.matchesWeekday(day.Weekday()) {
+			return nil
+		}
+		return []time.Time{day}
+	case RecurrenceFreqWeekly:
+		// NOTE: 週の始まりは月曜日(WKST=MO)とする
+		weekStart := dtstart.AddDate(0, 0, -((int(dtstart.Weekday())+6)%7)+period*r.Interval*7)
+		if len(r.ByDay) == 0 {
+			return []time.Time{weekStart.AddDate(0, 0, (int(dtstart.Weekday())+6)%7)}
+		}
+		days := []time.Time{}
+		for _, byDay := range r.ByDay {
+			days = append(days, weekStart.AddDate(0, 0, (int(byDay.Weekday)+6)%7))
+		}
+		return sortedUniqueTimes(days)
+	case RecurrenceFreqMonthly:
+		year, month, _ := dtstart.Date()
+		monthStart := time.Date(year, month+time.Month(period*r.Interval), 1, dtstart.Hour(), dtstart.Minute(), dtstart.Second(), 0, dtstart.Location())
+		if len(r.ByDay) == 0 {
+			day := monthStart.AddDate(0, 0, dtstart.Day()-1)
+			// NOTE: 31日などその月に存在しない日はスキップする
+			if day.Month() != monthStart.Month() {
+				return nil
+			}
+			return []time.Time{day}
+		}
+		days := []time.Time{}
+		for _, byDay := range r.ByDay {
+			days = append(days, monthlyWeekdays(monthStart, byDay)...)
+		}
+		return sortedUniqueTimes(days)
+	}
+	return nil
+}
+
+func (r *RecurrenceRule) matchesWeekday(weekday time.Weekday) bool {
+	for _, byDay := range r.ByDay {
+		if byDay.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func monthlyWeekdays(monthStart time.Time, byDay RecurrenceByDay) []time.Time {
+	days := []time.Time{}
+	for day := monthStart; day.Month() == monthStart.Month(); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == byDay.Weekday {
+			days = append(days, day)
+		}
+	}
+
+	switch {
+	case byDay.Ordinal == 0:
+		return days
+	case byDay.Ordinal > 0 && byDay.Ordinal <= len(days):
+		return []time.Time{days[byDay.Ordinal-1]}
+	case byDay.Ordinal < 0 && -byDay.Ordinal <= len(days):
+		return []time.Time{days[len(days)+byDay.Ordinal]}
+	}
+	return nil
+}
+
+func parseRecurrenceByDay(value string) (RecurrenceByDay, error) {
+	if len(value) < 2 {
+		return RecurrenceByDay{}, fmt.Errorf("invalid BYDAY: %s", value)
+	}
+	weekday, ok := recurrenceWeekdays[value[len(value)-2:]]
+	if !ok {
+		return RecurrenceByDay{}, fmt.Errorf("invalid BYDAY: %s", value)
+	}
+
+	byDay := RecurrenceByDay{Weekday: weekday}
+	if ordinal := value[:len(value)-2]; ordinal != "" {
+		n, err := strconv.Atoi(ordinal)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return RecurrenceByDay{}, fmt.Errorf("invalid BYDAY: %s", value)
+		}
+		byDay.Ordinal = n
+	}
+	return byDay, nil
+}
+
+func parseRecurrenceUntil(value string) (time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, nil
+	}
+	for _, layout := range []string{"20060102T150405", "20060102"} {
+		if until, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			if layout == "20060102" {
+				// NOTE: 日付のみの場合はその日の終わりまでを含める
+				until = until.Add(24*time.Hour - time.Second)
+			}
+			return until, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL: %s", value)
+}
+
+func sortedUniqueTimes(times []time.Time) []time.Time {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	unique := []time.Time{}
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			unique = append(unique, t)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestRecurrenceSuite struct {
+	suite.Suite
+}
+
+func (s *TestRecurrenceSuite) TestParseRecurrenceRule() {
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;COUNT=4")
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), RecurrenceFreqWeekly, rule.Freq)
+	assert.Equal(s.T(), 2, rule.Interval)
+	assert.Equal(s.T(), []RecurrenceByDay{{Weekday: time.Monday}, {Weekday: time.Friday}}, rule.ByDay)
+	assert.Equal(s.T(), 4, rule.Count)
+}
+
+func (s *TestRecurrenceSuite) TestParseRecurrenceRule_Invalid() {
+	invalidRules := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=DAILY;COUNT=3;UNTIL=20240101",
+	}
+	for _, invalidRule := range invalidRules {
+		_, err := ParseRecurrenceRule(invalidRule)
+		assert.NotNil(s.T(), err, invalidRule)
+	}
+}
+
+func (s *TestRecurrenceSuite) TestOccurrences_Daily() {
+	rule, _ := ParseRecurrenceRule("FREQ=DAILY;INTERVAL=3")
+	dtstart := time.Date(2024, 1, 30, 9, 0, 0, 0, time.UTC)
+
+	occurrences := rule.Occurrences(dtstart, dtstart, 3)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 2, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 5, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 8, 9, 0, 0, 0, time.UTC),
+	}, occurrences)
+}
+
+func (s *TestRecurrenceSuite) TestOccurrences_WeeklyByDay() {
+	rule, _ := ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO,WE")
+	// NOTE: 2024/1/3は水曜日
+	dtstart := time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)
+
+	occurrences := rule.Occurrences(dtstart, dtstart, 3)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+	}, occurrences)
+}
+
+func (s *TestRecurrenceSuite) TestOccurrences_MonthlySkipsMissingDays() {
+	rule, _ := ParseRecurrenceRule("FREQ=MONTHLY")
+	dtstart := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	occurrences := rule.Occurrences(dtstart, dtstart, 2)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 31, 9, 0, 0, 0, time.UTC),
+	}, occurrences)
+}
+
+func (s *TestRecurrenceSuite) TestOccurrences_MonthlyByDayOrdinal() {
+	rule, _ := ParseRecurrenceRule("FREQ=MONTHLY;BYDAY=-1FR")
+	dtstart := time.Date(2024, 1, 26, 18, 0, 0, 0, time.UTC)
+
+	occurrences := rule.Occurrences(dtstart, dtstart, 2)
+
+	assert.Equal(s.T(), []time.Time{
+		time.Date(2024, 2, 23, 18, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 29, 18, 0, 0, 0, time.UTC),
+	}, occurrences)
+}
+
+func (s *TestRecurrenceSuite) TestOccurrences_Count() {
+	rule, _ := ParseRecurrenceRule("FREQ=DAILY;COUNT=3")
+	dtstart := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// NOTE: COUNTはdtstartを含めて数える
+	occurrences := rule.Occurrences(dtstart, dtstart, 10)
+
+	assert.Len(s.T(), occurrences, 2)
+	assert.Nil(s.T(), rule.Next(dtstart, occurrences[1]))
+}
+
+func (s *TestRecurrenceSuite) TestOccurrences_Until() {
+	rule, _ := ParseRecurrenceRule("FREQ=WEEKLY;UNTIL=20240115T000000Z")
+	dtstart := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	occurrences := rule.Occurrences(dtstart, dtstart, 10)
+
+	assert.Equal(s.T(), []time.Time{time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)}, occurrences)
+}
+
+func TestRecurrence(t *testing.T) {
+	// テストスイートを実施
+	suite.Run(t, new(TestRecurrenceSuite))
+}
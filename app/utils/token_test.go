@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestTokenSuite struct {
+	suite.Suite
+}
+
+func (s *TestTokenSuite) TestGenerateToken() {
+	token, err := GenerateToken(32)
+	otherToken, _ := GenerateToken(32)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), token, 43)
+	assert.NotContains(s.T(), token, "/")
+	assert.NotEqual(s.T(), token, otherToken)
+}
+
+func TestToken(t *testing.T) {
+	suite.Run(t, new(TestTokenSuite))
+}
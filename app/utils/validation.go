@@ -17,6 +17,10 @@ func CoordinateValidationErrors(validationErrors error) map[string][]string {
 		switch err.ActualTag() {
 		case "required":
 			errors[field] = append(errors[field], fmt.Sprintf("%sは必須です", err.Field()))
+		case "required_with":
+			errors[field] = append(errors[field], fmt.Sprintf("%sは%sを指定する場合は必須です", err.Field(), err.Param()))
+		case "oneof":
+			errors[field] = append(errors[field], fmt.Sprintf("%sは%sのいずれかを指定してください", err.Field(), err.Param()))
 		}
 	}
 